@@ -0,0 +1,11 @@
+data "exoscale_anti_affinity_group_members" "my_anti_affinity_group_members" {
+  name = "my-anti-affinity-group"
+}
+
+output "my_anti_affinity_group_members" {
+  value = join("\n", formatlist(
+    "%s (%s)",
+    data.exoscale_anti_affinity_group_members.my_anti_affinity_group_members.members.*.name,
+    data.exoscale_anti_affinity_group_members.my_anti_affinity_group_members.members.*.zone,
+  ))
+}
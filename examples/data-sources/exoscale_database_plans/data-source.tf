@@ -0,0 +1,16 @@
+data "exoscale_database_plans" "pg" {
+  type = "pg"
+  zone = "ch-gva-2"
+}
+
+locals {
+  # Smallest plan with at least 2 nodes and 8 GiB of memory per node.
+  pg_plan = [
+    for p in data.exoscale_database_plans.pg.plans : p
+    if p.nodes >= 2 && p.node_memory >= 8 * 1024 * 1024 * 1024
+  ][0].name
+}
+
+output "pg_plan" {
+  value = local.pg_plan
+}
@@ -0,0 +1,13 @@
+data "exoscale_database_settings" "pg" {
+  type = "pg"
+  zone = "ch-gva-2"
+}
+
+locals {
+  # Names of the settings supported in the pg_settings of a pg service.
+  pg_settings = keys(jsondecode(data.exoscale_database_settings.pg.schemas["pg"]).properties)
+}
+
+output "pg_settings" {
+  value = local.pg_settings
+}
@@ -0,0 +1,7 @@
+data "exoscale_elastic_ip_reverse_dns" "my_zone" {
+  zone = "ch-gva-2"
+}
+
+output "elastic_ips_without_reverse_dns" {
+  value = data.exoscale_elastic_ip_reverse_dns.my_zone.missing
+}
@@ -0,0 +1,5 @@
+data "exoscale_iam_caller_identity" "current" {}
+
+output "api_key_name" {
+  value = data.exoscale_iam_caller_identity.current.name
+}
@@ -0,0 +1,17 @@
+data "exoscale_quota" "instances" {
+  zone     = "ch-gva-2"
+  resource = "instance"
+}
+
+locals {
+  instance_quota = data.exoscale_quota.instances.quotas[0]
+}
+
+resource "terraform_data" "instance_quota_check" {
+  lifecycle {
+    precondition {
+      condition     = local.instance_quota.limit == -1 || local.instance_quota.usage + 3 <= local.instance_quota.limit
+      error_message = "Not enough compute instance quota left."
+    }
+  }
+}
@@ -0,0 +1,8 @@
+data "exoscale_template_list" "ubuntu" {
+  zone = "ch-gva-2"
+  name = "/^Linux Ubuntu 22\\.04 LTS/"
+}
+
+output "latest_ubuntu_template_id" {
+  value = data.exoscale_template_list.ubuntu.templates[0].id
+}
@@ -0,0 +1,15 @@
+data "exoscale_zone" "zone" {
+  name = "ch-gva-2"
+}
+
+# Only create the cluster if SKS is available in the zone.
+resource "exoscale_sks_cluster" "cluster" {
+  count = data.exoscale_zone.zone.sks_available ? 1 : 0
+
+  zone = data.exoscale_zone.zone.name
+  name = "my-sks-cluster"
+}
+
+output "sos_endpoint" {
+  value = data.exoscale_zone.zone.sos_endpoint
+}
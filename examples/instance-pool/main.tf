@@ -30,7 +30,7 @@ resource "exoscale_instance_pool" "my_instance_pool" {
   disk_size     = 10
   size          = 3
 
-  key_pair = exoscale_ssh_key.my_ssh_key.name
+  ssh_keys = [exoscale_ssh_key.my_ssh_key.name]
 
   security_group_ids = [
     data.exoscale_security_group.default.id,
@@ -0,0 +1,5 @@
+# An existing database ACL may be imported by `<service>/<username>@<zone>`:
+
+terraform import \
+  exoscale_database_acl.my_acl \
+  my-database/my-app@ch-gva-2
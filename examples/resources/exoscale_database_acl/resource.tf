@@ -0,0 +1,25 @@
+resource "exoscale_database" "my_database" {
+  zone = "ch-gva-2"
+  name = "my-database"
+
+  type = "opensearch"
+  plan = "startup-4"
+
+  opensearch {}
+}
+
+resource "exoscale_database_acl" "my_acl" {
+  zone     = exoscale_database.my_database.zone
+  service  = exoscale_database.my_database.name
+  username = "my-app"
+
+  rule {
+    pattern    = "logs-*"
+    permission = "readwrite"
+  }
+
+  rule {
+    pattern    = "metrics-*"
+    permission = "read"
+  }
+}
@@ -0,0 +1,5 @@
+# An existing database connection pool may be imported by `<service>/<name>@<zone>`:
+
+terraform import \
+  exoscale_database_connection_pool.my_pool \
+  my-database/my-pool@ch-gva-2
@@ -0,0 +1,26 @@
+resource "exoscale_database" "my_database" {
+  zone = "ch-gva-2"
+  name = "my-database"
+
+  type = "pg"
+  plan = "startup-4"
+
+  pg {}
+}
+
+resource "exoscale_database_user" "my_user" {
+  zone     = exoscale_database.my_database.zone
+  service  = exoscale_database.my_database.name
+  username = "my-app"
+}
+
+resource "exoscale_database_connection_pool" "my_pool" {
+  zone     = exoscale_database.my_database.zone
+  service  = exoscale_database.my_database.name
+  name     = "my-pool"
+  database = "defaultdb"
+  username = exoscale_database_user.my_user.username
+
+  mode = "transaction"
+  size = 10
+}
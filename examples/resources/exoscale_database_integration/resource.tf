@@ -0,0 +1,26 @@
+resource "exoscale_database" "my_database" {
+  zone = "ch-gva-2"
+  name = "my-database"
+
+  type = "pg"
+  plan = "startup-4"
+
+  pg {}
+}
+
+resource "exoscale_database" "my_grafana" {
+  zone = "ch-gva-2"
+  name = "my-grafana"
+
+  type = "grafana"
+  plan = "hobbyist-2"
+
+  grafana {}
+}
+
+resource "exoscale_database_integration" "my_integration" {
+  zone           = "ch-gva-2"
+  type           = "datasource"
+  source_service = exoscale_database.my_database.name
+  dest_service   = exoscale_database.my_grafana.name
+}
@@ -0,0 +1,5 @@
+# An existing database user may be imported by `<service>/<username>@<zone>`:
+
+terraform import \
+  exoscale_database_user.my_user \
+  my-database/my-app@ch-gva-2
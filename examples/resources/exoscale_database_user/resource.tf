@@ -0,0 +1,15 @@
+resource "exoscale_database" "my_database" {
+  zone = "ch-gva-2"
+  name = "my-database"
+
+  type = "pg"
+  plan = "startup-4"
+
+  pg {}
+}
+
+resource "exoscale_database_user" "my_user" {
+  zone     = exoscale_database.my_database.zone
+  service  = exoscale_database.my_database.name
+  username = "my-app"
+}
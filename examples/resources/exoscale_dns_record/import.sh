@@ -0,0 +1,12 @@
+# An existing DNS domain record may be imported by `<ID>`:
+
+terraform import \
+  exoscale_dns_record.my_host \
+  f81d4fae-7dec-11d0-a765-00a0c91e6bf6
+
+# Or by `<domain>/<name>/<type>` (leave the name blank for a root record),
+# as long as a single record matches:
+
+terraform import \
+  exoscale_dns_record.my_host \
+  example.net/my-host/A
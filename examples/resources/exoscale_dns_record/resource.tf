@@ -0,0 +1,30 @@
+resource "exoscale_dns_domain" "my_domain" {
+  name = "example.net"
+}
+
+resource "exoscale_dns_record" "my_host" {
+  domain      = exoscale_dns_domain.my_domain.id
+  name        = "my-host"
+  record_type = "A"
+  content     = "1.2.3.4"
+}
+
+resource "exoscale_dns_record" "my_host_alias" {
+  domain      = exoscale_dns_domain.my_domain.id
+  name        = "my-host-alias"
+  record_type = "CNAME"
+  content     = exoscale_dns_record.my_host.hostname
+}
+
+resource "exoscale_dns_record" "my_service" {
+  domain      = exoscale_dns_domain.my_domain.id
+  name        = "my-service"
+  record_type = "A"
+  content     = "1.2.3.4"
+
+  # Serve 5.6.7.8 instead if the healthcheck fails (evaluated at apply time)
+  failover {
+    secondary_content = "5.6.7.8"
+    healthcheck_url   = "http://1.2.3.4/healthz"
+  }
+}
@@ -0,0 +1,6 @@
+# The records of an existing DNS domain may be imported by domain `<ID>` or `<name>`
+# (all the records of the domain but the SOA and root NS records end up in the state):
+
+terraform import \
+  exoscale_dns_records.my_domain \
+  example.net
@@ -0,0 +1,35 @@
+resource "exoscale_dns_domain" "my_domain" {
+  name = "example.net"
+}
+
+resource "exoscale_dns_records" "my_domain" {
+  domain = exoscale_dns_domain.my_domain.id
+
+  # Root record
+  record {
+    name        = ""
+    record_type = "A"
+    content     = "1.2.3.4"
+  }
+
+  # Records sharing the same name and type
+  record {
+    name        = "www"
+    record_type = "A"
+    content     = "1.2.3.4"
+  }
+
+  record {
+    name        = "www"
+    record_type = "A"
+    content     = "5.6.7.8"
+    ttl         = 300
+  }
+
+  record {
+    name        = ""
+    record_type = "MX"
+    content     = "mail.example.net"
+    prio        = 10
+  }
+}
@@ -3,3 +3,10 @@
 terraform import \
   exoscale_domain_record.my_host \
   f81d4fae-7dec-11d0-a765-00a0c91e6bf6
+
+# Or by `<domain>/<name>/<type>` (leave the name blank for a root record),
+# as long as a single record matches:
+
+terraform import \
+  exoscale_domain_record.my_host \
+  example.net/my-host/A
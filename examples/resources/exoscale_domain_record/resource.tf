@@ -15,3 +15,16 @@ resource "exoscale_domain_record" "my_host_alias" {
   record_type = "CNAME"
   content     = exoscale_domain_record.my_host.hostname
 }
+
+resource "exoscale_domain_record" "my_service" {
+  domain      = exoscale_domain.my_domain.id
+  name        = "my-service"
+  record_type = "A"
+  content     = "1.2.3.4"
+
+  # Serve 5.6.7.8 instead if the healthcheck fails (evaluated at apply time)
+  failover {
+    secondary_content = "5.6.7.8"
+    healthcheck_url   = "http://1.2.3.4/healthz"
+  }
+}
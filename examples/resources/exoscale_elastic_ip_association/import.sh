@@ -0,0 +1,5 @@
+# Import the association of an Elastic IP (identified by its ID) with the
+# instances it is currently attached to:
+terraform import \
+  exoscale_elastic_ip_association.my_elastic_ip_association \
+  eb556678-ec59-4be6-8c54-0406ae0f6da6@ch-gva-2
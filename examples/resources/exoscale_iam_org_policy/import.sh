@@ -0,0 +1,4 @@
+# The organization policy is a singleton: any ID may be used.
+terraform import \
+  exoscale_iam_org_policy.org_policy \
+  organization-policy
@@ -0,0 +1,5 @@
+# An existing snapshot may be imported by `<ID>@<zone>`:
+
+terraform import \
+  exoscale_snapshot.my_snapshot \
+  f81d4fae-7dec-11d0-a765-00a0c91e6bf6@ch-gva-2
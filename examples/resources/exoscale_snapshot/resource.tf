@@ -0,0 +1,10 @@
+resource "exoscale_compute_instance" "my_instance" {
+  zone = "ch-gva-2"
+  name = "my-instance"
+  # ...
+}
+
+resource "exoscale_snapshot" "my_snapshot" {
+  zone        = "ch-gva-2"
+  instance_id = exoscale_compute_instance.my_instance.id
+}
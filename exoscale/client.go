@@ -1,9 +1,13 @@
 package exoscale
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"log"
 	"net/http"
+	"strings"
+	"sync"
 
 	"github.com/exoscale/egoscale"
 	exov2 "github.com/exoscale/egoscale/v2"
@@ -11,7 +15,10 @@ import (
 
 	cleanhttp "github.com/hashicorp/go-cleanhttp"
 	retryablehttp "github.com/hashicorp/go-retryablehttp"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/logging"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/meta"
 
 	providerConfig "github.com/exoscale/terraform-provider-exoscale/pkg/provider/config"
@@ -37,11 +44,12 @@ func getConfig(meta interface{}) providerConfig.BaseConfig {
 	return t["config"].(providerConfig.BaseConfig)
 }
 
-func getClient(endpoint string, meta interface{}) *egoscale.Client {
+func getClient(endpoint string, meta interface{}, retryOpts ...func(*retryablehttp.Client)) *egoscale.Client {
 	config := getConfig(meta)
 
 	httpClient := cleanhttp.DefaultPooledClient()
-	httpClient.Transport = &defaultTransport{next: httpClient.Transport}
+	httpClient.Transport = &defaultTransport{next: invalidateListCache(config, warnDeprecations(limitConcurrency(config, httpClient.Transport)))}
+	httpClient.Transport = enforceReadOnly(config, httpClient.Transport)
 	if logging.IsDebugOrHigher() {
 		httpClient.Transport = logging.NewSubsystemLoggingHTTPTransport(
 			"exoscale",
@@ -70,7 +78,12 @@ func getClient(endpoint string, meta interface{}) *egoscale.Client {
 		exov2.ClientOptWithHTTPClient(func() *http.Client {
 			rc := retryablehttp.NewClient()
 			rc.Logger = LeveledTFLogger{Verbose: logging.IsDebugOrHigher()}
+			rc.HTTPClient.Transport = invalidateListCache(config, warnDeprecations(limitConcurrency(config, rc.HTTPClient.Transport)))
+			for _, opt := range retryOpts {
+				opt(rc)
+			}
 			hc := rc.StandardClient()
+			hc.Transport = enforceReadOnly(config, hc.Transport)
 			if logging.IsDebugOrHigher() {
 				hc.Transport = logging.NewSubsystemLoggingHTTPTransport("exoscale", hc.Transport)
 			}
@@ -94,15 +107,51 @@ func GetComputeClient(meta interface{}) *egoscale.Client {
 	return config.ComputeClient
 }
 
-// GetDNSClient builds a DNS client
+// dnsAPI represents the subset of the Exoscale API client used to manage DNS
+// domains and records, allowing to substitute the API in unit tests.
+type dnsAPI interface {
+	ListDNSDomains(ctx context.Context, zone string) ([]exov2.DNSDomain, error)
+	GetDNSDomain(ctx context.Context, zone, id string) (*exov2.DNSDomain, error)
+	CreateDNSDomain(ctx context.Context, zone string, domain *exov2.DNSDomain) (*exov2.DNSDomain, error)
+	DeleteDNSDomain(ctx context.Context, zone string, domain *exov2.DNSDomain) error
+	ListDNSDomainRecords(ctx context.Context, zone, id string) ([]exov2.DNSDomainRecord, error)
+	GetDNSDomainRecord(ctx context.Context, zone, domainID, recordID string) (*exov2.DNSDomainRecord, error)
+	CreateDNSDomainRecord(ctx context.Context, zone, domainID string, record *exov2.DNSDomainRecord) (*exov2.DNSDomainRecord, error)
+	UpdateDNSDomainRecord(ctx context.Context, zone, domainID string, record *exov2.DNSDomainRecord) error
+	DeleteDNSDomainRecord(ctx context.Context, zone, domainID string, record *exov2.DNSDomainRecord) error
+}
+
+var _ dnsAPI = (*exov2.Client)(nil)
+
+// getDNSAPI returns the API client used to manage DNS domains and records: the
+// DNS client, unless substituted in the provider meta (e.g. in unit tests).
+func getDNSAPI(meta interface{}) dnsAPI {
+	if api, ok := meta.(map[string]interface{})["dns_api"].(dnsAPI); ok {
+		return api
+	}
+	return GetDNSClient(meta).Client
+}
+
+// GetDNSClient builds a client dedicated to DNS operations, using the DNS endpoint
+// and a retry policy independent from the compute one.
 func GetDNSClient(meta interface{}) *egoscale.Client {
 	config := getConfig(meta)
 	if config.DNSClient == nil {
-		config.DNSClient = getClient(config.DNSEndpoint, meta)
+		config.DNSClient = getClient(config.DNSEndpoint, meta, withDNSRetries(config.DNSMaxRetries))
 	}
 	return config.DNSClient
 }
 
+// withDNSRetries configures a retryable HTTP client to retry failed requests
+// (5xx and 429 responses, connection errors) up to maxRetries times, using a
+// jittered backoff to spread retries during API maintenance windows.
+func withDNSRetries(maxRetries int) func(*retryablehttp.Client) {
+	return func(rc *retryablehttp.Client) {
+		rc.RetryMax = maxRetries
+		rc.Backoff = retryablehttp.LinearJitterBackoff
+	}
+}
+
 func getEnvironment(meta interface{}) string {
 	config := getConfig(meta)
 	if config.Environment == "" {
@@ -111,6 +160,15 @@ func getEnvironment(meta interface{}) string {
 	return config.Environment
 }
 
+// getDefaultZone returns the zone used for zone-agnostic operations (DNS, global resources).
+func getDefaultZone(meta interface{}) string {
+	config := getConfig(meta)
+	if config.DefaultZone == "" {
+		return defaultZone
+	}
+	return config.DefaultZone
+}
+
 type defaultTransport struct {
 	next http.RoundTripper
 }
@@ -127,6 +185,284 @@ func (t *defaultTransport) RoundTrip(req *http.Request) (*http.Response, error)
 	return resp, nil
 }
 
+// concurrencyLimitTransport is an http.RoundTripper bounding the number of
+// in-flight API requests using a semaphore shared by all the provider clients.
+type concurrencyLimitTransport struct {
+	sem  chan struct{}
+	next http.RoundTripper
+}
+
+// limitConcurrency wraps the next http.RoundTripper with a concurrencyLimitTransport
+// if the provider is configured with a maximum API requests concurrency.
+func limitConcurrency(config providerConfig.BaseConfig, next http.RoundTripper) http.RoundTripper {
+	if config.APISemaphore == nil {
+		return next
+	}
+
+	return &concurrencyLimitTransport{sem: config.APISemaphore, next: next}
+}
+
+// RoundTrip executes a single HTTP transaction once a slot is available in the semaphore.
+func (t *concurrencyLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	select {
+	case t.sem <- struct{}{}:
+	case <-req.Context().Done():
+		return nil, req.Context().Err()
+	}
+	defer func() { <-t.sem }()
+
+	return t.next.RoundTrip(req)
+}
+
+// listCacheTransport is an http.RoundTripper resetting the provider list cache
+// after every request which may modify resources, so that data sources read
+// after a resource change never get stale list responses. As the changes are
+// applied asynchronously, the cache is also reset after every poll of an
+// operation (or V1 async job), dropping the list responses cached while it
+// was still pending.
+type listCacheTransport struct {
+	cache *providerConfig.ListCache
+	next  http.RoundTripper
+}
+
+// invalidateListCache wraps the next http.RoundTripper with a listCacheTransport
+// if the provider is configured with a list cache.
+func invalidateListCache(config providerConfig.BaseConfig, next http.RoundTripper) http.RoundTripper {
+	if config.ListCache == nil {
+		return next
+	}
+
+	return &listCacheTransport{cache: config.ListCache, next: next}
+}
+
+// RoundTrip executes a single HTTP transaction, resetting the list cache unless
+// read-only and not polling an operation.
+func (t *listCacheTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.next.RoundTrip(req)
+
+	if !isReadOnlyRequest(req) || isOperationPollRequest(req) {
+		t.cache.Reset()
+	}
+
+	return resp, err
+}
+
+// isOperationPollRequest reports whether the request polls the state of an
+// asynchronous operation: V2 API GET /operation/{id} requests, and V1 API
+// queryAsyncJobResult commands.
+func isOperationPollRequest(req *http.Request) bool {
+	if strings.EqualFold(req.URL.Query().Get("command"), "queryAsyncJobResult") {
+		return true
+	}
+
+	return strings.Contains(req.URL.Path, "/operation/")
+}
+
+// readOnlyTransport is an http.RoundTripper refusing the requests which may modify
+// resources, for the provider read-only mode.
+type readOnlyTransport struct {
+	next http.RoundTripper
+}
+
+// enforceReadOnly wraps the next http.RoundTripper with a readOnlyTransport if the
+// provider is configured in read-only mode. It must wrap any retrying transport,
+// so that refused requests are not retried.
+func enforceReadOnly(config providerConfig.BaseConfig, next http.RoundTripper) http.RoundTripper {
+	if !config.ReadOnly {
+		return next
+	}
+
+	return &readOnlyTransport{next: next}
+}
+
+// RoundTrip executes a single HTTP transaction, unless it may modify resources.
+func (t *readOnlyTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if isReadOnlyRequest(req) {
+		return t.next.RoundTrip(req)
+	}
+
+	operation := req.Method + " " + req.URL.Path
+	if command := req.URL.Query().Get("command"); command != "" {
+		operation += " (" + command + ")"
+	}
+
+	tflog.Warn(req.Context(), "read-only mode: refusing API request", map[string]interface{}{
+		"operation": operation,
+	})
+
+	return nil, fmt.Errorf("%w: refusing to perform %s", ErrReadOnly, operation)
+}
+
+// ErrReadOnly is the error returned for the requests refused in read-only mode.
+var ErrReadOnly = errors.New("the provider is in read-only mode (read_only)")
+
+// isReadOnlyRequest reports whether the request can't modify resources: V2 API
+// read requests use the GET method, while V1 API ones are GET requests of
+// list*, get* or query* commands.
+func isReadOnlyRequest(req *http.Request) bool {
+	if req.Method != http.MethodGet && req.Method != http.MethodHead {
+		return false
+	}
+
+	command := strings.ToLower(req.URL.Query().Get("command"))
+	if command == "" {
+		return true
+	}
+
+	for _, prefix := range []string{"list", "get", "query"} {
+		if strings.HasPrefix(command, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// cachedList returns the response of the list operation op in zone, served from
+// the provider list cache if enabled, calling fetch otherwise. The returned value
+// is shared among callers and must not be modified.
+func cachedList(meta interface{}, op, zone string, fetch func() (interface{}, error)) (interface{}, error) {
+	return getConfig(meta).ListCache.Get(op, zone, fetch)
+}
+
+// deprecationTransport is an http.RoundTripper logging the deprecation notices
+// returned by the API (Warning, Deprecation and Sunset response headers).
+// Each notice is only logged once per provider process, and recorded in the
+// deprecationNotices of the request context if any.
+type deprecationTransport struct {
+	next http.RoundTripper
+}
+
+// deprecationNotices collects the API deprecation notices received during a
+// resource operation, to report them as warning diagnostics.
+type deprecationNotices struct {
+	mu       sync.Mutex
+	warnings []string
+}
+
+type deprecationNoticesKey struct{}
+
+// withDeprecationNotices returns a context collecting the API deprecation notices
+// of the requests performed with it.
+func withDeprecationNotices(ctx context.Context) (context.Context, *deprecationNotices) {
+	notices := &deprecationNotices{}
+	return context.WithValue(ctx, deprecationNoticesKey{}, notices), notices
+}
+
+func (n *deprecationNotices) add(warning string) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	if !in(n.warnings, warning) {
+		n.warnings = append(n.warnings, warning)
+	}
+}
+
+// diagnostics returns the collected notices as warning diagnostics.
+func (n *deprecationNotices) diagnostics() diag.Diagnostics {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	var diags diag.Diagnostics
+	for _, warning := range n.warnings {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Exoscale API deprecation notice",
+			Detail:   warning,
+		})
+	}
+
+	return diags
+}
+
+// withDeprecationDiagnostics wraps the operations of the resource r so that the
+// API deprecation notices received while performing them are reported as warning
+// diagnostics, in addition to the operations own diagnostics.
+func withDeprecationDiagnostics(r *schema.Resource) *schema.Resource {
+	wrap := func(f func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+		if f == nil {
+			return nil
+		}
+
+		return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			ctx, notices := withDeprecationNotices(ctx)
+			diags := f(ctx, d, meta)
+			return append(diags, notices.diagnostics()...)
+		}
+	}
+
+	r.CreateContext = wrap(r.CreateContext)
+	r.ReadContext = wrap(r.ReadContext)
+	r.UpdateContext = wrap(r.UpdateContext)
+	r.DeleteContext = wrap(r.DeleteContext)
+
+	return r
+}
+
+var loggedDeprecationWarnings sync.Map
+
+// warnDeprecations wraps the next http.RoundTripper with a deprecationTransport.
+func warnDeprecations(next http.RoundTripper) http.RoundTripper {
+	return &deprecationTransport{next: next}
+}
+
+// RoundTrip executes a single HTTP transaction, logging deprecation notices of the response.
+func (t *deprecationTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	notices, _ := req.Context().Value(deprecationNoticesKey{}).(*deprecationNotices)
+
+	for _, warning := range apiDeprecationWarnings(resp) {
+		if notices != nil {
+			notices.add(warning)
+		}
+
+		if _, logged := loggedDeprecationWarnings.LoadOrStore(warning, struct{}{}); logged {
+			continue
+		}
+
+		tflog.Warn(req.Context(), "Exoscale API deprecation notice", map[string]interface{}{
+			"method":  req.Method,
+			"path":    req.URL.Path,
+			"warning": warning,
+		})
+	}
+
+	return resp, nil
+}
+
+// apiDeprecationWarnings returns the deprecation notices found in the response headers.
+// The response is never rejected: notices are informational only.
+func apiDeprecationWarnings(resp *http.Response) []string {
+	warnings := make([]string, 0)
+
+	// Warning: <code> <agent> "<text>" [<date>] (RFC 7234 section 5.5)
+	for _, v := range resp.Header.Values("Warning") {
+		text := v
+		if start := strings.Index(v, `"`); start >= 0 {
+			if end := strings.Index(v[start+1:], `"`); end >= 0 {
+				text = v[start+1 : start+1+end]
+			}
+		}
+		if text = strings.TrimSpace(text); text != "" {
+			warnings = append(warnings, text)
+		}
+	}
+
+	if resp.Header.Get("Deprecation") != "" {
+		warning := fmt.Sprintf("%s %s is deprecated", resp.Request.Method, resp.Request.URL.Path)
+		if sunset := resp.Header.Get("Sunset"); sunset != "" {
+			warning += fmt.Sprintf(" and will be removed on %s", sunset)
+		}
+		warnings = append(warnings, warning)
+	}
+
+	return warnings
+}
+
 // LeveledTFLogger is a thin wrapper around stdlib.log that satisfies retryablehttp.LeveledLogger interface.
 type LeveledTFLogger struct {
 	Verbose bool
@@ -1,8 +1,19 @@
 package exoscale
 
 import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
+	exov2 "github.com/exoscale/egoscale/v2"
+	retryablehttp "github.com/hashicorp/go-retryablehttp"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/stretchr/testify/require"
 
 	"github.com/exoscale/terraform-provider-exoscale/pkg/config"
@@ -23,3 +34,371 @@ func Test_getClient(t *testing.T) {
 	require.Equal(t, testEndpoint, client.Endpoint)
 	require.Equal(t, testConfig.Timeout, client.Timeout)
 }
+
+type testRoundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f testRoundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) { return f(req) }
+
+func Test_concurrencyLimitTransport(t *testing.T) {
+	var (
+		maxConcurrency = 2
+		inFlight       int32
+		maxInFlight    int32
+		wg             sync.WaitGroup
+	)
+
+	transport := limitConcurrency(
+		providerConfig.BaseConfig{APISemaphore: providerConfig.NewAPISemaphore(maxConcurrency)},
+		testRoundTripperFunc(func(_ *http.Request) (*http.Response, error) {
+			n := atomic.AddInt32(&inFlight, 1)
+			for {
+				m := atomic.LoadInt32(&maxInFlight)
+				if n <= m || atomic.CompareAndSwapInt32(&maxInFlight, m, n) {
+					break
+				}
+			}
+			time.Sleep(10 * time.Millisecond)
+			atomic.AddInt32(&inFlight, -1)
+			return &http.Response{StatusCode: http.StatusOK}, nil
+		}),
+	)
+
+	errs := make(chan error, 10)
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			req, _ := http.NewRequest(http.MethodGet, DefaultComputeEndpoint, nil)
+			_, err := transport.RoundTrip(req)
+			errs <- err
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		require.NoError(t, err)
+	}
+	require.LessOrEqual(t, int(maxInFlight), maxConcurrency)
+}
+
+func Test_SharedAPISemaphore(t *testing.T) {
+	require.Nil(t, providerConfig.SharedAPISemaphore("EXOtest", 0))
+
+	sem := providerConfig.SharedAPISemaphore("EXOtest", 2)
+	require.Equal(t, 2, cap(sem))
+	require.True(t, sem == providerConfig.SharedAPISemaphore("EXOtest", 2), "expected the same semaphore")
+	require.False(t, sem == providerConfig.SharedAPISemaphore("EXOother", 2), "expected a semaphore per API key")
+}
+
+func Test_apiDeprecationWarnings(t *testing.T) {
+	req, _ := http.NewRequest(http.MethodGet, DefaultComputeEndpoint+"/instance", nil)
+
+	tests := []struct {
+		name    string
+		headers http.Header
+		want    []string
+	}{
+		{
+			name:    "no deprecation",
+			headers: http.Header{},
+			want:    []string{},
+		},
+		{
+			name: "warning header",
+			headers: http.Header{
+				"Warning": []string{`299 - "field foo is deprecated, use bar" "Wed, 21 Oct 2026 07:28:00 GMT"`},
+			},
+			want: []string{"field foo is deprecated, use bar"},
+		},
+		{
+			name: "deprecation and sunset headers",
+			headers: http.Header{
+				"Deprecation": []string{"true"},
+				"Sunset":      []string{"Wed, 21 Oct 2026 07:28:00 GMT"},
+			},
+			want: []string{"GET /v1/instance is deprecated and will be removed on Wed, 21 Oct 2026 07:28:00 GMT"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := &http.Response{StatusCode: http.StatusOK, Header: tt.headers, Request: req}
+			require.Equal(t, tt.want, apiDeprecationWarnings(resp))
+		})
+	}
+}
+
+func Test_withDeprecationDiagnostics(t *testing.T) {
+	transport := warnDeprecations(testRoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{"Warning": []string{`299 - "field foo is deprecated, use bar"`}},
+			Request:    req,
+		}, nil
+	}))
+
+	r := withDeprecationDiagnostics(&schema.Resource{
+		ReadContext: func(ctx context.Context, _ *schema.ResourceData, _ interface{}) diag.Diagnostics {
+			for i := 0; i < 2; i++ {
+				req, _ := http.NewRequestWithContext(ctx, http.MethodGet, DefaultComputeEndpoint+"/instance", nil)
+				if _, err := transport.RoundTrip(req); err != nil {
+					return diag.FromErr(err)
+				}
+			}
+			return nil
+		},
+	})
+
+	diags := r.ReadContext(context.Background(), nil, nil)
+	require.False(t, diags.HasError())
+	require.Len(t, diags, 1, "expected the notice reported once")
+	require.Equal(t, diag.Warning, diags[0].Severity)
+	require.Equal(t, "field foo is deprecated, use bar", diags[0].Detail)
+	require.Nil(t, r.CreateContext, "expected undefined operations left undefined")
+}
+
+func Test_withDNSRetries(t *testing.T) {
+	tests := []struct {
+		name       string
+		maxRetries int
+		failures   int
+		status     int
+		wantErr    bool
+	}{
+		{name: "recovers from 503", maxRetries: 2, failures: 2, status: http.StatusServiceUnavailable},
+		{name: "recovers from 429", maxRetries: 2, failures: 1, status: http.StatusTooManyRequests},
+		{name: "gives up after max retries", maxRetries: 1, failures: 2, status: http.StatusServiceUnavailable, wantErr: true},
+		{name: "retries disabled", maxRetries: 0, failures: 1, status: http.StatusServiceUnavailable, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var hits int32
+
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				if int(atomic.AddInt32(&hits, 1)) <= tt.failures {
+					w.WriteHeader(tt.status)
+					return
+				}
+				w.WriteHeader(http.StatusOK)
+			}))
+			defer ts.Close()
+
+			rc := retryablehttp.NewClient()
+			rc.Logger = nil
+			withDNSRetries(tt.maxRetries)(rc)
+			rc.RetryWaitMin = time.Millisecond
+			rc.RetryWaitMax = 5 * time.Millisecond
+
+			resp, err := rc.Get(ts.URL)
+			if tt.wantErr {
+				require.Error(t, err)
+				require.Equal(t, tt.maxRetries+1, int(atomic.LoadInt32(&hits)))
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, http.StatusOK, resp.StatusCode)
+			require.Equal(t, tt.failures+1, int(atomic.LoadInt32(&hits)))
+		})
+	}
+}
+
+// testDNSAPI is a dnsAPI implementation for unit tests: only the methods
+// having a corresponding function field set can be called.
+type testDNSAPI struct {
+	dnsAPI
+
+	listDNSDomains        func(ctx context.Context, zone string) ([]exov2.DNSDomain, error)
+	listDNSDomainRecords  func(ctx context.Context, zone, id string) ([]exov2.DNSDomainRecord, error)
+	deleteDNSDomainRecord func(ctx context.Context, zone, domainID string, record *exov2.DNSDomainRecord) error
+}
+
+func (c *testDNSAPI) ListDNSDomains(ctx context.Context, zone string) ([]exov2.DNSDomain, error) {
+	return c.listDNSDomains(ctx, zone)
+}
+
+func (c *testDNSAPI) ListDNSDomainRecords(ctx context.Context, zone, id string) ([]exov2.DNSDomainRecord, error) {
+	return c.listDNSDomainRecords(ctx, zone, id)
+}
+
+func (c *testDNSAPI) DeleteDNSDomainRecord(ctx context.Context, zone, domainID string, record *exov2.DNSDomainRecord) error {
+	return c.deleteDNSDomainRecord(ctx, zone, domainID, record)
+}
+
+func Test_findDNSDomainByName(t *testing.T) {
+	domains := []exov2.DNSDomain{
+		{ID: nonEmptyStringPtr("1"), UnicodeName: nonEmptyStringPtr("example.net")},
+		{ID: nonEmptyStringPtr("2"), UnicodeName: nonEmptyStringPtr("example.com")},
+		{ID: nonEmptyStringPtr("3"), UnicodeName: nonEmptyStringPtr("example.io")},
+		{ID: nonEmptyStringPtr("4"), UnicodeName: nonEmptyStringPtr("example.io")},
+	}
+
+	tests := []struct {
+		name    string
+		domain  string
+		listErr error
+		wantID  string
+		wantErr string
+	}{
+		{name: "found", domain: "example.com", wantID: "2"},
+		{name: "not found", domain: "example.org", wantErr: `domain "example.org" not found`},
+		{name: "list error", domain: "example.com", listErr: errors.New("boom"), wantErr: "boom"},
+		{name: "duplicate", domain: "example.io", wantErr: `multiple domains named "example.io", specify id`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &testDNSAPI{
+				listDNSDomains: func(_ context.Context, zone string) ([]exov2.DNSDomain, error) {
+					require.Equal(t, defaultZone, zone)
+					return domains, tt.listErr
+				},
+			}
+
+			domain, err := findDNSDomainByName(context.Background(), client, defaultZone, tt.domain)
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.wantID, *domain.ID)
+		})
+	}
+}
+
+func Test_resourceDomainUserRecords(t *testing.T) {
+	record := func(id, name, recordType string) exov2.DNSDomainRecord {
+		return exov2.DNSDomainRecord{
+			ID:   nonEmptyStringPtr(id),
+			Name: &name,
+			Type: nonEmptyStringPtr(recordType),
+		}
+	}
+
+	client := &testDNSAPI{
+		listDNSDomainRecords: func(_ context.Context, _, id string) ([]exov2.DNSDomainRecord, error) {
+			require.Equal(t, "domain-id", id)
+			return []exov2.DNSDomainRecord{
+				record("1", "", "SOA"),
+				record("2", "", "NS"),
+				record("3", "sub", "NS"),
+				record("4", "www", "A"),
+			}, nil
+		},
+	}
+
+	records, err := resourceDomainUserRecords(context.Background(), client, defaultZone, "domain-id")
+	require.NoError(t, err)
+
+	ids := make([]string, 0, len(records))
+	for _, r := range records {
+		ids = append(ids, *r.ID)
+	}
+	require.Equal(t, []string{"3", "4"}, ids)
+}
+
+func Test_dnsDomainNameservers(t *testing.T) {
+	record := func(name, recordType, content string) exov2.DNSDomainRecord {
+		return exov2.DNSDomainRecord{
+			Name:    &name,
+			Type:    nonEmptyStringPtr(recordType),
+			Content: nonEmptyStringPtr(content),
+		}
+	}
+
+	client := &testDNSAPI{
+		listDNSDomainRecords: func(_ context.Context, _, id string) ([]exov2.DNSDomainRecord, error) {
+			require.Equal(t, "domain-id", id)
+			return []exov2.DNSDomainRecord{
+				record("", "SOA", "ns1.exoscale.ch admin.dnsimple.com"),
+				record("", "NS", "ns1.exoscale.io"),
+				record("", "NS", "ns1.exoscale.ch"),
+				record("sub", "NS", "ns.example.net"),
+				record("www", "A", "1.2.3.4"),
+			}, nil
+		},
+	}
+
+	nameservers, err := dnsDomainNameservers(context.Background(), client, defaultZone, "domain-id")
+	require.NoError(t, err)
+	require.Equal(t, []string{"ns1.exoscale.ch", "ns1.exoscale.io"}, nameservers)
+}
+
+func Test_listCacheTransport(t *testing.T) {
+	cache := providerConfig.NewListCache(true)
+
+	transport := invalidateListCache(
+		providerConfig.BaseConfig{ListCache: cache},
+		testRoundTripperFunc(func(_ *http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: http.StatusOK}, nil
+		}),
+	)
+
+	tests := []struct {
+		method      string
+		url         string
+		invalidates bool
+	}{
+		{http.MethodGet, DefaultComputeEndpoint + "/private-network", false},
+		{http.MethodPost, DefaultComputeEndpoint + "/private-network", true},
+		{http.MethodPut, DefaultComputeEndpoint + "/private-network/x", true},
+		{http.MethodDelete, DefaultComputeEndpoint + "/private-network/x", true},
+		{http.MethodGet, DefaultComputeEndpoint + "?command=listNetworks", false},
+		{http.MethodGet, DefaultComputeEndpoint + "?command=queryAsyncJobResult", true},
+		{http.MethodGet, DefaultComputeEndpoint + "/operation/x", true},
+		{http.MethodGet, DefaultComputeEndpoint + "?command=createNetwork", true},
+	}
+
+	for _, tt := range tests {
+		calls := 0
+		fetch := func() (interface{}, error) {
+			calls++
+			return calls, nil
+		}
+
+		_, _ = cache.Get("ListNetworks", "ch-gva-2", fetch)
+
+		req, _ := http.NewRequest(tt.method, tt.url, nil)
+		_, err := transport.RoundTrip(req)
+		require.NoError(t, err)
+
+		_, _ = cache.Get("ListNetworks", "ch-gva-2", fetch)
+		require.Equal(t, tt.invalidates, calls == 2, "%s %s", tt.method, tt.url)
+
+		cache.Reset()
+	}
+}
+
+func Test_readOnlyTransport(t *testing.T) {
+	next := testRoundTripperFunc(func(_ *http.Request) (*http.Response, error) {
+		return &http.Response{StatusCode: http.StatusOK}, nil
+	})
+
+	_, wrapped := enforceReadOnly(providerConfig.BaseConfig{}, next).(*readOnlyTransport)
+	require.False(t, wrapped, "expected no read-only transport by default")
+
+	transport := enforceReadOnly(providerConfig.BaseConfig{ReadOnly: true}, next)
+
+	tests := []struct {
+		method  string
+		url     string
+		allowed bool
+	}{
+		{http.MethodGet, DefaultComputeEndpoint + "/private-network", true},
+		{http.MethodPost, DefaultComputeEndpoint + "/private-network", false},
+		{http.MethodDelete, DefaultComputeEndpoint + "/private-network/x", false},
+		{http.MethodGet, DefaultComputeEndpoint + "?command=listNetworks", true},
+		{http.MethodGet, DefaultComputeEndpoint + "?command=createNetwork", false},
+	}
+
+	for _, tt := range tests {
+		req, _ := http.NewRequest(tt.method, tt.url, nil)
+		_, err := transport.RoundTrip(req)
+		if tt.allowed {
+			require.NoError(t, err, "%s %s", tt.method, tt.url)
+			continue
+		}
+		require.ErrorIs(t, err, ErrReadOnly, "%s %s", tt.method, tt.url)
+	}
+}
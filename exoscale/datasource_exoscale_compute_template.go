@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/exoscale/egoscale"
+	exoapi "github.com/exoscale/egoscale/v2/api"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -29,7 +30,7 @@ func dataSourceComputeTemplate() *schema.Resource {
 				ConflictsWith: []string{"id"},
 			},
 			"id": {
-				Description:   "The compute instance template ID to match (conflicts with `name`).",
+				Description:   "The compute instance template ID to match (conflicts with `name`). The template is then fetched directly, regardless of `filter`.",
 				Type:          schema.TypeString,
 				Optional:      true,
 				ConflictsWith: []string{"name"},
@@ -48,6 +49,21 @@ func dataSourceComputeTemplate() *schema.Resource {
 				Description: "Username for logging into a compute instance based on this template",
 				Computed:    true,
 			},
+			"boot_mode": {
+				Type:        schema.TypeString,
+				Description: "The template boot mode (`legacy` or `uefi`).",
+				Computed:    true,
+			},
+			"default_user": {
+				Type:        schema.TypeString,
+				Description: "The template default user, as reported by the template details.",
+				Computed:    true,
+			},
+			"password_enabled": {
+				Type:        schema.TypeBool,
+				Description: "Whether a password is generated for compute instances based on this template.",
+				Computed:    true,
+			},
 		},
 
 		Read: dataSourceComputeTemplateRead,
@@ -61,42 +77,107 @@ func dataSourceComputeTemplateRead(d *schema.ResourceData, meta interface{}) err
 	client := GetComputeClient(meta)
 
 	zoneName := d.Get("zone").(string)
-	zone, err := getZoneByName(ctx, client, zoneName)
+
+	templateName, byName := d.GetOk("name")
+	templateID, byID := d.GetOk("id")
+	if !byName && !byID {
+		return errors.New("either name or id must be specified")
+	}
+
+	var username string
+	if byID {
+		// The template details are fetched directly, bypassing the templates list lookup.
+		if _, err := egoscale.ParseUUID(templateID.(string)); err != nil {
+			return fmt.Errorf("invalid value for id: %s", err)
+		}
+	} else {
+		template, err := dataSourceComputeTemplateFindByName(ctx, client, zoneName, templateName.(string), d.Get("filter").(string))
+		if err != nil {
+			return err
+		}
+		templateID = template.ID.String()
+
+		if v, ok := template.Details["username"]; ok {
+			username = v
+		}
+	}
+
+	details, err := client.GetTemplate(
+		exoapi.WithEndpoint(ctx, exoapi.NewReqEndpoint(getEnvironment(meta), zoneName)),
+		zoneName,
+		templateID.(string),
+	)
 	if err != nil {
+		if byID && errors.Is(err, exoapi.ErrNotFound) {
+			return fmt.Errorf("template %q not found in zone %s", templateID, zoneName)
+		}
+		return fmt.Errorf("unable to retrieve template details: %s", err)
+	}
+
+	d.SetId(*details.ID)
+
+	if err := d.Set("id", d.Id()); err != nil {
+		return err
+	}
+	if err := d.Set("name", defaultString(details.Name, "")); err != nil {
 		return err
 	}
 
-	req := egoscale.ListTemplates{
-		ZoneID:         zone.ID,
-		TemplateFilter: d.Get("filter").(string),
+	if err := d.Set("boot_mode", defaultString(details.BootMode, "")); err != nil {
+		return err
+	}
+	if err := d.Set("default_user", defaultString(details.DefaultUser, "")); err != nil {
+		return err
+	}
+	if err := d.Set("password_enabled", defaultBool(details.PasswordEnabled, false)); err != nil {
+		return err
 	}
 
-	// Template filter "mine" is a friendlier alias for "self"
-	if req.TemplateFilter == "mine" {
-		req.TemplateFilter = "self"
+	if username == "" {
+		username = defaultString(details.DefaultUser, "")
+	}
+	if username == "" {
+		// If no username information provided in the template details,
+		// attempt an educated guess based on the template name
+		username = getSSHUsername(defaultString(details.Name, ""))
+	}
+	if err := d.Set("username", username); err != nil {
+		return err
 	}
 
-	templateName, byName := d.GetOk("name")
-	templateID, byID := d.GetOk("id")
-	if !byName && !byID {
-		return errors.New("either name or id must be specified")
+	return nil
+}
+
+// dataSourceComputeTemplateFindByName returns the most recent template named name
+// among the templates of the specified category.
+func dataSourceComputeTemplateFindByName(
+	ctx context.Context,
+	client *egoscale.Client,
+	zoneName, name, filter string,
+) (*egoscale.Template, error) {
+	zone, err := getZoneByName(ctx, client, zoneName)
+	if err != nil {
+		return nil, err
 	}
 
-	req.Name = templateName.(string)
+	req := egoscale.ListTemplates{
+		ZoneID:         zone.ID,
+		Name:           name,
+		TemplateFilter: filter,
+	}
 
-	if templateID != "" {
-		if req.ID, err = egoscale.ParseUUID(templateID.(string)); err != nil {
-			return fmt.Errorf("invalid value for id: %s", err)
-		}
+	// Template filter "mine" is a friendlier alias for "self"
+	if req.TemplateFilter == "mine" {
+		req.TemplateFilter = "self"
 	}
 
 	resp, err := client.ListWithContext(ctx, &req)
 	if err != nil {
-		return fmt.Errorf("templates list query failed: %s", err)
+		return nil, fmt.Errorf("templates list query failed: %s", err)
 	}
 
 	if len(resp) == 0 {
-		return errors.New("template not found")
+		return nil, errors.New("template not found")
 	}
 
 	// In case multiple results are returned, we pick the most recent item from the list.
@@ -107,7 +188,7 @@ func dataSourceComputeTemplateRead(d *schema.ResourceData, meta interface{}) err
 	for _, t := range resp {
 		ts, err := time.Parse("2006-01-02T15:04:05-0700", t.(*egoscale.Template).Created)
 		if err != nil {
-			return fmt.Errorf("template creation date parsing error: %s", err)
+			return nil, fmt.Errorf("template creation date parsing error: %s", err)
 		}
 
 		if ts.After(templateDate) {
@@ -116,26 +197,5 @@ func dataSourceComputeTemplateRead(d *schema.ResourceData, meta interface{}) err
 		}
 	}
 
-	d.SetId(template.ID.String())
-
-	if err := d.Set("id", d.Id()); err != nil {
-		return err
-	}
-	if err := d.Set("name", template.Name); err != nil {
-		return err
-	}
-
-	if username, ok := template.Details["username"]; ok {
-		if err := d.Set("username", username); err != nil {
-			return err
-		}
-	} else {
-		// If no username information provided in the template details,
-		// attempt an educated guess based on the template name
-		if err := d.Set("username", getSSHUsername(template.Name)); err != nil {
-			return err
-		}
-	}
-
-	return nil
+	return template, nil
 }
@@ -5,6 +5,7 @@ import (
 	"regexp"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
@@ -31,6 +32,15 @@ data "exoscale_compute_template" "test" {
 			},
 			{
 				Config: fmt.Sprintf(`
+data "exoscale_compute_template" "test" {
+  zone = "%s"
+  id   = "00000000-0000-0000-0000-000000000000"
+}`,
+					testAccDataSourceComputeTemplateZone),
+				ExpectError: regexp.MustCompile(`template "00000000-0000-0000-0000-000000000000" not found in zone`),
+			},
+			{
+				Config: fmt.Sprintf(`
 data "exoscale_compute_template" "by_name" {
   zone   = "%s"
   name   = "%s"
@@ -49,12 +59,18 @@ data "exoscale_compute_template" "by_id" {
 				),
 				Check: resource.ComposeTestCheckFunc(
 					testAccDataSourceComputeTemplateAttributes("by_name", testAttrs{
-						"name":     validateString(testAccDataSourceComputeTemplateName),
-						"username": validateString(testAccDataSourceComputeTemplateUsername),
+						"name":             validateString(testAccDataSourceComputeTemplateName),
+						"username":         validateString(testAccDataSourceComputeTemplateUsername),
+						"default_user":     validateString(testAccDataSourceComputeTemplateUsername),
+						"boot_mode":        validation.ToDiagFunc(validation.StringInSlice([]string{"legacy", "uefi"}, false)),
+						"password_enabled": validation.ToDiagFunc(validation.StringInSlice([]string{"true", "false"}, false)),
 					}),
 					testAccDataSourceComputeTemplateAttributes("by_id", testAttrs{
-						"name":     validateString(testAccDataSourceComputeTemplateName),
-						"username": validateString(testAccDataSourceComputeTemplateUsername),
+						"name":             validateString(testAccDataSourceComputeTemplateName),
+						"username":         validateString(testAccDataSourceComputeTemplateUsername),
+						"default_user":     validateString(testAccDataSourceComputeTemplateUsername),
+						"boot_mode":        validation.ToDiagFunc(validation.StringInSlice([]string{"legacy", "uefi"}, false)),
+						"password_enabled": validation.ToDiagFunc(validation.StringInSlice([]string{"true", "false"}, false)),
 					}),
 				),
 			},
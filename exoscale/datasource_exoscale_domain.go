@@ -2,10 +2,12 @@ package exoscale
 
 import (
 	"context"
+	"fmt"
 
 	exo "github.com/exoscale/egoscale/v2"
 	exoapi "github.com/exoscale/egoscale/v2/api"
 	"github.com/exoscale/terraform-provider-exoscale/pkg/general"
+	"github.com/exoscale/terraform-provider-exoscale/pkg/utils"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -23,6 +25,12 @@ Corresponding resource: [exoscale_domain](../resources/domain.md).`,
 				Type:        schema.TypeString,
 				Required:    true,
 			},
+			"nameservers": {
+				Description: "The authoritative nameservers of the DNS domain (i.e. the apex `NS` records), to configure the delegation at the registrar.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
 		},
 		ReadContext: dataSourceDomainRead,
 	}
@@ -34,29 +42,14 @@ func dataSourceDomainRead(ctx context.Context, d *schema.ResourceData, meta inte
 	})
 
 	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutRead))
-	ctx = exoapi.WithEndpoint(ctx, exoapi.NewReqEndpoint(getEnvironment(meta), defaultZone))
+	ctx = exoapi.WithEndpoint(ctx, exoapi.NewReqEndpoint(getEnvironment(meta), getDefaultZone(meta)))
 	defer cancel()
 
-	client := GetComputeClient(meta)
-
-	domainName := d.Get("name")
-	var domain *exo.DNSDomain
+	client := getDNSAPI(meta)
 
-	domains, err := client.ListDNSDomains(ctx, defaultZone)
+	domain, err := findDNSDomainByName(ctx, client, getDefaultZone(meta), d.Get("name").(string))
 	if err != nil {
-		return diag.Errorf("error retrieving domain list: %s", err)
-	}
-
-	for _, item := range domains {
-		if *item.UnicodeName == domainName {
-			t := item
-			domain = &t
-			break
-		}
-	}
-
-	if domain == nil {
-		return diag.Errorf("domain %q not found", domainName)
+		return diag.FromErr(err)
 	}
 
 	d.SetId(*domain.ID)
@@ -66,9 +59,39 @@ func dataSourceDomainRead(ctx context.Context, d *schema.ResourceData, meta inte
 		return diag.FromErr(err)
 	}
 
+	if err := resourceDomainSetNameservers(ctx, d, client, getDefaultZone(meta)); err != nil {
+		return diag.FromErr(err)
+	}
+
 	tflog.Debug(ctx, "read finished successfully", map[string]interface{}{
 		"id": general.ResourceIDString(d, "exoscale_domain"),
 	})
 
 	return nil
 }
+
+// findDNSDomainByName returns the DNS domain matching the specified name,
+// failing with utils.ErrTooManyFound if several domains match.
+func findDNSDomainByName(ctx context.Context, client dnsAPI, zone, name string) (*exo.DNSDomain, error) {
+	domains, err := client.ListDNSDomains(ctx, zone)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving domain list: %w", err)
+	}
+
+	var domain *exo.DNSDomain
+	for _, item := range domains {
+		if defaultString(item.UnicodeName, "") == name {
+			if domain != nil {
+				return nil, utils.TooManyFoundError("domains", name)
+			}
+			item := item
+			domain = &item
+		}
+	}
+
+	if domain == nil {
+		return nil, fmt.Errorf("domain %q not found", name)
+	}
+
+	return domain, nil
+}
@@ -120,29 +120,14 @@ func dataSourceDomainRecordRead(ctx context.Context, d *schema.ResourceData, met
 	})
 
 	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutRead))
-	ctx = exoapi.WithEndpoint(ctx, exoapi.NewReqEndpoint(getEnvironment(meta), defaultZone))
+	ctx = exoapi.WithEndpoint(ctx, exoapi.NewReqEndpoint(getEnvironment(meta), getDefaultZone(meta)))
 	defer cancel()
 
-	client := GetComputeClient(meta)
+	client := getDNSAPI(meta)
 
-	domainName := d.Get("domain").(string)
-	var domain *exo.DNSDomain
-
-	domains, err := client.ListDNSDomains(ctx, defaultZone)
+	domain, err := findDNSDomainByName(ctx, client, getDefaultZone(meta), d.Get("domain").(string))
 	if err != nil {
-		return diag.Errorf("error retrieving domain list: %s", err)
-	}
-
-	for _, item := range domains {
-		if *item.UnicodeName == domainName {
-			t := item
-			domain = &t
-			break
-		}
-	}
-
-	if domain == nil {
-		return diag.Errorf("domain %q not found", domainName)
+		return diag.FromErr(err)
 	}
 
 	flist := d.Get("filter").([]interface{})
@@ -161,14 +146,14 @@ func dataSourceDomainRecordRead(ctx context.Context, d *schema.ResourceData, met
 
 	switch {
 	case id != "":
-		record, err := client.GetDNSDomainRecord(ctx, defaultZone, *domain.ID, id)
+		record, err := client.GetDNSDomainRecord(ctx, getDefaultZone(meta), *domain.ID, id)
 		if err != nil {
 			return diag.Errorf("error retrieving domain record: %s", err)
 		}
 		records = append(records, *record)
 		ids = append(ids, *record.ID)
 	case name != "" || rtype != "":
-		r, err := client.ListDNSDomainRecords(ctx, defaultZone, *domain.ID)
+		r, err := client.ListDNSDomainRecords(ctx, getDefaultZone(meta), *domain.ID)
 		if err != nil {
 			return diag.Errorf("error retrieving domain record list: %s", err)
 		}
@@ -188,7 +173,7 @@ func dataSourceDomainRecordRead(ctx context.Context, d *schema.ResourceData, met
 		if err != nil {
 			return diag.Errorf("error parsing regex: %s", err)
 		}
-		r, err := client.ListDNSDomainRecords(ctx, defaultZone, *domain.ID)
+		r, err := client.ListDNSDomainRecords(ctx, getDefaultZone(meta), *domain.ID)
 		if err != nil {
 			return diag.Errorf("error retrieving domain record list: %s", err)
 		}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -27,7 +28,8 @@ data "exoscale_domain" "domain" {
 }`, testAccDataSourceDomainName),
 				Check: resource.ComposeTestCheckFunc(
 					testAccDataSourceDomainAttributes(testAttrs{
-						"name": validateString(testAccDataSourceDomainName),
+						"name":          validateString(testAccDataSourceDomainName),
+						"nameservers.0": validation.ToDiagFunc(validation.NoZeroValues),
 					}),
 				),
 			},
@@ -197,10 +197,13 @@ func dataSourceElasticIPRead(ctx context.Context, d *schema.ResourceData, meta i
 		}
 	}
 
-	elasticIPs, err := client.ListElasticIPs(ctx, zone)
+	res, err := cachedList(meta, "ListElasticIPs", zone, func() (interface{}, error) {
+		return client.ListElasticIPs(ctx, zone)
+	})
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	elasticIPs := res.([]*egoscale.ElasticIP)
 
 	var elasticIP *egoscale.ElasticIP
 	for _, eip := range elasticIPs {
@@ -0,0 +1,106 @@
+package exoscale
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	exoapi "github.com/exoscale/egoscale/v2/api"
+
+	"github.com/exoscale/terraform-provider-exoscale/pkg/utils"
+)
+
+const (
+	dsElasticIPReverseDNSAttrMissing    = "missing"
+	dsElasticIPReverseDNSAttrReverseDNS = "reverse_dns"
+	dsElasticIPReverseDNSAttrZone       = "zone"
+)
+
+func dataSourceElasticIPReverseDNS() *schema.Resource {
+	return &schema.Resource{
+		Description: `Fetch the reverse DNS (PTR) records of all the Exoscale [Elastic IPs](https://community.exoscale.com/documentation/compute/eip/) of a zone.
+
+This is useful to audit the reverse DNS coverage of the Elastic IPs.`,
+		Schema: map[string]*schema.Schema{
+			dsElasticIPReverseDNSAttrMissing: {
+				Description: "The list of Elastic IP addresses without reverse DNS record.",
+				Type:        schema.TypeSet,
+				Set:         schema.HashString,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Computed:    true,
+			},
+			dsElasticIPReverseDNSAttrReverseDNS: {
+				Description: "A map of Elastic IP address to reverse DNS domain name (empty if the address has no reverse DNS record).",
+				Type:        schema.TypeMap,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Computed:    true,
+			},
+			dsElasticIPReverseDNSAttrZone: {
+				Description:      "The Exoscale [Zone](https://www.exoscale.com/datacenters/) name.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: utils.ValidateZone(),
+			},
+		},
+
+		ReadContext: dataSourceElasticIPReverseDNSRead,
+	}
+}
+
+func dataSourceElasticIPReverseDNSRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tflog.Debug(ctx, "beginning read", map[string]interface{}{
+		"id": "exoscale_elastic_ip_reverse_dns",
+	})
+
+	zone := d.Get(dsElasticIPReverseDNSAttrZone).(string)
+
+	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutRead))
+	ctx = exoapi.WithEndpoint(ctx, exoapi.NewReqEndpoint(getEnvironment(meta), zone))
+	defer cancel()
+
+	client := GetComputeClient(meta)
+
+	elasticIPs, err := client.ListElasticIPs(ctx, zone)
+	if err != nil {
+		return diag.Errorf("unable to list Elastic IPs: %s", err)
+	}
+
+	reverseDNS := make(map[string]interface{}, len(elasticIPs))
+	missing := make([]string, 0)
+	for _, elasticIP := range elasticIPs {
+		if elasticIP.ID == nil || elasticIP.IPAddress == nil {
+			continue
+		}
+
+		rdns, err := client.GetElasticIPReverseDNS(ctx, zone, *elasticIP.ID)
+		if err != nil && !errors.Is(err, exoapi.ErrNotFound) {
+			return diag.Errorf("unable to retrieve Elastic IP %q reverse-dns: %s", *elasticIP.ID, err)
+		}
+
+		address := elasticIP.IPAddress.String()
+		reverseDNS[address] = strings.TrimSuffix(rdns, ".")
+		if rdns == "" {
+			missing = append(missing, address)
+		}
+	}
+
+	d.SetId(zone)
+
+	if err := d.Set(dsElasticIPReverseDNSAttrReverseDNS, reverseDNS); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(dsElasticIPReverseDNSAttrMissing, missing); err != nil {
+		return diag.FromErr(err)
+	}
+
+	tflog.Debug(ctx, "read finished successfully", map[string]interface{}{
+		"id": "exoscale_elastic_ip_reverse_dns",
+	})
+
+	return nil
+}
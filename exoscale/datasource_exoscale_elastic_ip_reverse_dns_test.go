@@ -0,0 +1,61 @@
+package exoscale
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccDataSourceElasticIPReverseDNS(t *testing.T) {
+	dsName := "data.exoscale_elastic_ip_reverse_dns.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "exoscale_elastic_ip" "with_rdns" {
+  zone        = "%s"
+  reverse_dns = "%s"
+}
+
+resource "exoscale_elastic_ip" "without_rdns" {
+  zone = "%s"
+}
+
+data "exoscale_elastic_ip_reverse_dns" "test" {
+  zone = "%s"
+
+  depends_on = [
+    exoscale_elastic_ip.with_rdns,
+    exoscale_elastic_ip.without_rdns,
+  ]
+}`,
+					testZoneName,
+					testAccResourceElasticIPReverseDNS,
+					testZoneName,
+					testZoneName,
+				),
+				Check: resource.ComposeTestCheckFunc(
+					func(s *terraform.State) error {
+						withRDNS := s.RootModule().Resources["exoscale_elastic_ip.with_rdns"].Primary.Attributes["ip_address"]
+						withoutRDNS := s.RootModule().Resources["exoscale_elastic_ip.without_rdns"].Primary.Attributes["ip_address"]
+
+						return resource.ComposeTestCheckFunc(
+							resource.TestCheckResourceAttr(
+								dsName,
+								dsElasticIPReverseDNSAttrReverseDNS+"."+withRDNS,
+								testAccResourceElasticIPReverseDNS,
+							),
+							resource.TestCheckResourceAttr(dsName, dsElasticIPReverseDNSAttrReverseDNS+"."+withoutRDNS, ""),
+							resource.TestCheckTypeSetElemAttr(dsName, dsElasticIPReverseDNSAttrMissing+".*", withoutRDNS),
+						)(s)
+					},
+				),
+			},
+		},
+	})
+}
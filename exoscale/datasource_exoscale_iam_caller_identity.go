@@ -0,0 +1,131 @@
+package exoscale
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	exoapi "github.com/exoscale/egoscale/v2/api"
+)
+
+const (
+	dsIAMCallerIdentityAttrKey        = "key"
+	dsIAMCallerIdentityAttrName       = "name"
+	dsIAMCallerIdentityAttrOperations = "operations"
+	dsIAMCallerIdentityAttrRoleID     = "role_id"
+	dsIAMCallerIdentityAttrType       = "type"
+)
+
+func dataSourceIAMCallerIdentity() *schema.Resource {
+	return &schema.Resource{
+		Description: `Fetch information about the Exoscale [API key](https://community.exoscale.com/documentation/iam/) the provider is configured with.
+
+This is useful to fail fast when the configured key lacks the permissions a configuration requires.`,
+		Schema: map[string]*schema.Schema{
+			dsIAMCallerIdentityAttrKey: {
+				Description: "The API key.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			dsIAMCallerIdentityAttrName: {
+				Description: "The API key name.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			dsIAMCallerIdentityAttrOperations: {
+				Description: "The list of API operations allowed for the API key.",
+				Type:        schema.TypeSet,
+				Set:         schema.HashString,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Computed:    true,
+			},
+			dsIAMCallerIdentityAttrRoleID: {
+				Description: "The ID of the IAM role attached to the API key (only set for role-based API keys).",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			dsIAMCallerIdentityAttrType: {
+				Description: "The API key type (`role` for role-based API keys, `restricted` or `unrestricted` for legacy access keys).",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+
+		ReadContext: dataSourceIAMCallerIdentityRead,
+	}
+}
+
+func dataSourceIAMCallerIdentityRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tflog.Debug(ctx, "beginning read", map[string]interface{}{
+		"id": "exoscale_iam_caller_identity",
+	})
+
+	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutRead))
+	ctx = exoapi.WithEndpoint(ctx, exoapi.NewReqEndpoint(getEnvironment(meta), getDefaultZone(meta)))
+	defer cancel()
+
+	client := GetComputeClient(meta)
+	key := getConfig(meta).Key
+
+	var name, roleID, keyType string
+
+	// Role-based API keys are looked up first, falling back to legacy access keys.
+	apiKey, err := client.GetApiKeyWithResponse(ctx, key)
+	switch {
+	case err == nil && apiKey.JSON200 != nil:
+		name = defaultString(apiKey.JSON200.Name, "")
+		roleID = defaultString(apiKey.JSON200.RoleId, "")
+		keyType = "role"
+
+	case err == nil || errors.Is(err, exoapi.ErrNotFound):
+		accessKey, err := client.GetIAMAccessKey(ctx, getDefaultZone(meta), key)
+		if err != nil {
+			return diag.Errorf("unable to retrieve API key: %s", err)
+		}
+		name = defaultString(accessKey.Name, "")
+		keyType = defaultString(accessKey.Type, "")
+
+	default:
+		return diag.Errorf("unable to retrieve API key: %s", err)
+	}
+
+	operations, err := client.ListMyIAMAccessKeyOperations(ctx, getDefaultZone(meta))
+	if err != nil {
+		return diag.Errorf("unable to list API key operations: %s", err)
+	}
+
+	d.SetId(key)
+
+	if err := d.Set(dsIAMCallerIdentityAttrKey, key); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(dsIAMCallerIdentityAttrName, name); err != nil {
+		return diag.FromErr(err)
+	}
+
+	ops := make([]string, len(operations))
+	for i, op := range operations {
+		ops[i] = op.Name
+	}
+	if err := d.Set(dsIAMCallerIdentityAttrOperations, ops); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(dsIAMCallerIdentityAttrRoleID, roleID); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(dsIAMCallerIdentityAttrType, keyType); err != nil {
+		return diag.FromErr(err)
+	}
+
+	tflog.Debug(ctx, "read finished successfully", map[string]interface{}{
+		"id": "exoscale_iam_caller_identity",
+	})
+
+	return nil
+}
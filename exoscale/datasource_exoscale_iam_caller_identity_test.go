@@ -0,0 +1,26 @@
+package exoscale
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDataSourceIAMCallerIdentity(t *testing.T) {
+	ds := "data.exoscale_iam_caller_identity.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `data "exoscale_iam_caller_identity" "test" {}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(ds, dsIAMCallerIdentityAttrKey),
+					resource.TestCheckResourceAttrSet(ds, dsIAMCallerIdentityAttrType),
+					resource.TestCheckResourceAttrSet(ds, dsIAMCallerIdentityAttrOperations+".#"),
+				),
+			},
+		},
+	})
+}
@@ -2,11 +2,15 @@ package exoscale
 
 import (
 	"context"
-	"errors"
 	"fmt"
 
 	"github.com/exoscale/egoscale"
+	exov2 "github.com/exoscale/egoscale/v2"
+	exoapi "github.com/exoscale/egoscale/v2/api"
+	"github.com/exoscale/terraform-provider-exoscale/pkg/utils"
 
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -56,19 +60,38 @@ func dataSourceNetwork() *schema.Resource {
 				Type:        schema.TypeString,
 				Computed:    true,
 			},
+			"labels": {
+				Description: "A map of key/value labels. If set, only networks having all these labels are matched.",
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"tags": {
+				Description: "A map of key/value tags. If set, only networks having all these tags are matched.",
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"ipv6_cidr": {
+				Description: "The IPv6 network prefix (CIDR notation), if the network has IPv6 enabled.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
 		},
 
-		Read: dataSourceNetworkRead,
+		ReadContext: dataSourceNetworkRead,
 	}
 }
 
-func dataSourceNetworkRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceNetworkRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var (
 		networkID   *egoscale.UUID
 		networkName string
 	)
 
-	ctx, cancel := context.WithTimeout(context.Background(), d.Timeout(schema.TimeoutCreate))
+	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutRead))
 	defer cancel()
 
 	client := GetComputeClient(meta)
@@ -76,13 +99,20 @@ func dataSourceNetworkRead(d *schema.ResourceData, meta interface{}) error {
 	zoneName := d.Get("zone").(string)
 	zone, err := getZoneByName(ctx, client, zoneName)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	_, byName := d.GetOk("name")
 	_, byID := d.GetOk("id")
-	if !byName && !byID {
-		return errors.New("either name or id must be specified")
+	filterTags, byTags := d.GetOk("tags")
+	filterLabels, byLabels := d.GetOk("labels")
+	if !byName && !byID && !byTags && !byLabels {
+		return diag.Diagnostics{{
+			Severity:      diag.Error,
+			Summary:       "Missing network search criterion",
+			Detail:        "either name, id, tags or labels must be specified",
+			AttributePath: cty.GetAttrPath("name"),
+		}}
 	}
 
 	if byName {
@@ -90,13 +120,43 @@ func dataSourceNetworkRead(d *schema.ResourceData, meta interface{}) error {
 	}
 	if byID {
 		if networkID, err = egoscale.ParseUUID(d.Get("id").(string)); err != nil {
-			return fmt.Errorf("invalid value for id: %s", err)
+			return diag.Diagnostics{{
+				Severity:      diag.Error,
+				Summary:       "Invalid network ID",
+				Detail:        fmt.Sprintf("invalid value for id: %s", err),
+				AttributePath: cty.GetAttrPath("id"),
+			}}
 		}
 	}
 
-	resp, err := client.ListWithContext(ctx, &egoscale.ListNetworks{ZoneID: zone.ID})
+	networks, err := cachedList(meta, "ListNetworks", zoneName, func() (interface{}, error) {
+		return client.ListWithContext(ctx, &egoscale.ListNetworks{ZoneID: zone.ID})
+	})
 	if err != nil {
-		return fmt.Errorf("networks listing failed: %s", err)
+		return diag.Errorf("networks listing failed: %s", err)
+	}
+	resp := networks.([]interface{})
+
+	// Labels are only exposed by the V2 API, where networks are known as Private Networks.
+	var networkLabels map[string]map[string]string
+	if byLabels {
+		res, err := cachedList(meta, "ListPrivateNetworks", zoneName, func() (interface{}, error) {
+			return client.ListPrivateNetworks(
+				exoapi.WithEndpoint(ctx, exoapi.NewReqEndpoint(getEnvironment(meta), zoneName)),
+				zoneName,
+			)
+		})
+		if err != nil {
+			return diag.Errorf("networks listing failed: %s", err)
+		}
+		privateNetworks := res.([]*exov2.PrivateNetwork)
+
+		networkLabels = make(map[string]map[string]string, len(privateNetworks))
+		for _, privateNetwork := range privateNetworks {
+			if privateNetwork.Labels != nil {
+				networkLabels[*privateNetwork.ID] = *privateNetwork.Labels
+			}
+		}
 	}
 
 	var network *egoscale.Network
@@ -108,42 +168,78 @@ func dataSourceNetworkRead(d *schema.ResourceData, meta interface{}) error {
 			network = net
 			break
 		}
+		if byID {
+			continue
+		}
 
-		// If search criterion is a name, check that there isn't multiple networks named
-		// identically before returning a match
-		if net.Name == networkName {
-			// We already found a match before -> multiple results
-			if network != nil {
-				return fmt.Errorf("found multiple networks named %q, please specify a unique ID instead", net.Name)
+		if byName && net.Name != networkName {
+			continue
+		}
+
+		if byTags {
+			tags := make(map[string]string, len(net.Tags))
+			for _, tag := range net.Tags {
+				tags[tag.Key] = tag.Value
 			}
-			network = net
+			if !dataSourceNetworkMapMatches(tags, filterTags.(map[string]interface{})) {
+				continue
+			}
+		}
+
+		if byLabels && !dataSourceNetworkMapMatches(networkLabels[net.ID.String()], filterLabels.(map[string]interface{})) {
+			continue
 		}
+
+		// Check that there isn't multiple networks matching the search criteria
+		// before returning a match
+		if network != nil {
+			if byName && !byTags && !byLabels {
+				return diag.Diagnostics{{
+					Severity:      diag.Error,
+					Summary:       "Ambiguous network name",
+					Detail:        utils.TooManyFoundError("networks", net.Name).Error(),
+					AttributePath: cty.GetAttrPath("name"),
+				}}
+			}
+
+			path := cty.GetAttrPath("tags")
+			if !byTags {
+				path = cty.GetAttrPath("labels")
+			}
+			return diag.Diagnostics{{
+				Severity:      diag.Error,
+				Summary:       "Ambiguous network search criteria",
+				Detail:        "found multiple networks matching the specified criteria, please specify a unique ID instead",
+				AttributePath: path,
+			}}
+		}
+		network = net
 	}
 	if network == nil {
-		return errors.New("network not found")
+		return diag.Errorf("network not found")
 	}
 
 	d.SetId(network.ID.String())
 
 	if err := d.Set("id", d.Id()); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err := d.Set("name", network.Name); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err := d.Set("description", network.DisplayText); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if network.StartIP != nil && network.EndIP != nil && network.Netmask != nil {
 		if err := d.Set("start_ip", network.StartIP.String()); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		if err := d.Set("end_ip", network.EndIP.String()); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		if err := d.Set("netmask", network.Netmask.String()); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else {
 		d.Set("start_ip", "") // nolint: errcheck
@@ -151,5 +247,49 @@ func dataSourceNetworkRead(d *schema.ResourceData, meta interface{}) error {
 		d.Set("netmask", "")  // nolint: errcheck
 	}
 
+	tags := make(map[string]interface{})
+	for _, tag := range network.Tags {
+		tags[tag.Key] = tag.Value
+	}
+	if err := d.Set("tags", tags); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Labels are only exposed by the V2 API, where networks are known as Private Networks.
+	privateNetwork, err := client.GetPrivateNetwork(
+		exoapi.WithEndpoint(ctx, exoapi.NewReqEndpoint(getEnvironment(meta), zoneName)),
+		zoneName,
+		d.Id(),
+	)
+	if err != nil {
+		return diag.Errorf("unable to retrieve network labels: %s", err)
+	}
+	labels := make(map[string]string)
+	if privateNetwork.Labels != nil {
+		labels = *privateNetwork.Labels
+	}
+	if err := d.Set("labels", labels); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if network.IP6CIDR != nil {
+		if err := d.Set("ipv6_cidr", network.IP6CIDR.String()); err != nil {
+			return diag.FromErr(err)
+		}
+	} else {
+		d.Set("ipv6_cidr", "") // nolint: errcheck
+	}
+
 	return nil
 }
+
+// dataSourceNetworkMapMatches reports whether m contains all the key/value pairs of filter.
+func dataSourceNetworkMapMatches(m map[string]string, filter map[string]interface{}) bool {
+	for k, v := range filter {
+		if value, ok := m[k]; !ok || value != v.(string) {
+			return false
+		}
+	}
+
+	return true
+}
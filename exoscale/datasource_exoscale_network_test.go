@@ -19,6 +19,7 @@ var (
 	testAccDataSourceNetworkStartIP        = "10.0.0.10"
 	testAccDataSourceNetworkEndIP          = "10.0.0.50"
 	testAccDataSourceNetworkNetmask        = "255.255.0.0"
+	testAccDataSourceNetworkTagValue       = acctest.RandString(10)
 	testAccDataSourceNetworkResourceConfig = fmt.Sprintf(`
 resource "exoscale_network" "test" {
   zone         = "%s"
@@ -27,13 +28,18 @@ resource "exoscale_network" "test" {
   start_ip     = "%s"
   end_ip       = "%s"
   netmask      = "%s"
+
+  tags = {
+    test = "%s"
+  }
 }`,
 		testAccDataSourceNetworkZone,
 		testAccDataSourceNetworkName,
 		testAccDataSourceNetworkDescription,
 		testAccDataSourceNetworkStartIP,
 		testAccDataSourceNetworkEndIP,
-		testAccDataSourceNetworkNetmask)
+		testAccDataSourceNetworkNetmask,
+		testAccDataSourceNetworkTagValue)
 )
 
 func TestAccDataSourceNetwork(t *testing.T) {
@@ -47,7 +53,7 @@ data "exoscale_network" "test" {
   zone = exoscale_network.test.zone
 }`,
 					testAccDataSourceNetworkResourceConfig),
-				ExpectError: regexp.MustCompile("either name or id must be specified"),
+				ExpectError: regexp.MustCompile("either name, id, tags or labels must be specified"),
 			},
 			{
 				Config: fmt.Sprintf(`%s
@@ -65,6 +71,8 @@ data "exoscale_network" "by-id" {
 						"start_ip":    validateString(testAccDataSourceNetworkStartIP),
 						"end_ip":      validateString(testAccDataSourceNetworkEndIP),
 						"netmask":     validateString(testAccDataSourceNetworkNetmask),
+						"ipv6_cidr":   validateString(""),
+						"tags.test":   validateString(testAccDataSourceNetworkTagValue),
 					}),
 				),
 			},
@@ -84,6 +92,24 @@ data "exoscale_network" "by-name" {
 						"start_ip":    validateString(testAccDataSourceNetworkStartIP),
 						"end_ip":      validateString(testAccDataSourceNetworkEndIP),
 						"netmask":     validateString(testAccDataSourceNetworkNetmask),
+						"ipv6_cidr":   validateString(""),
+						"tags.test":   validateString(testAccDataSourceNetworkTagValue),
+					}),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`%s
+data "exoscale_network" "by-tags" {
+  zone = exoscale_network.test.zone
+  tags = exoscale_network.test.tags
+}`,
+					testAccDataSourceNetworkResourceConfig),
+				Check: resource.ComposeTestCheckFunc(
+					testAccDataSourceNetworkAttributes("data.exoscale_network.by-tags", testAttrs{
+						"zone":      validateString(testAccDataSourceNetworkZone),
+						"id":        validation.ToDiagFunc(validation.IsUUID),
+						"name":      validateString(testAccDataSourceNetworkName),
+						"tags.test": validateString(testAccDataSourceNetworkTagValue),
 					}),
 				),
 			},
@@ -0,0 +1,118 @@
+package exoscale
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	exoapi "github.com/exoscale/egoscale/v2/api"
+
+	"github.com/exoscale/terraform-provider-exoscale/pkg/utils"
+)
+
+const (
+	dsQuotaAttrLimit    = "limit"
+	dsQuotaAttrQuotas   = "quotas"
+	dsQuotaAttrResource = "resource"
+	dsQuotaAttrUsage    = "usage"
+	dsQuotaAttrZone     = "zone"
+)
+
+func dataSourceQuota() *schema.Resource {
+	return &schema.Resource{
+		Description: `Fetch Exoscale organization [quotas](https://community.exoscale.com/documentation/platform/quotas/) (e.g. instances, Elastic IPs, Private Networks).
+
+This is useful to fail fast when a configuration would exceed the organization limits.`,
+		Schema: map[string]*schema.Schema{
+			dsQuotaAttrResource: {
+				Description: "The resource to match (e.g. `instance`, `elastic-ip`, `private-network`); all quotas are returned if unset.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			dsQuotaAttrQuotas: {
+				Description: "The list of matching quotas.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						dsQuotaAttrLimit: {
+							Description: "The resource limit (`-1` for unlimited).",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						dsQuotaAttrResource: {
+							Description: "The resource name.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						dsQuotaAttrUsage: {
+							Description: "The current resource usage.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+					},
+				},
+			},
+			dsQuotaAttrZone: {
+				Description:      "The Exoscale [Zone](https://www.exoscale.com/datacenters/) name.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: utils.ValidateZone(),
+			},
+		},
+
+		ReadContext: dataSourceQuotaRead,
+	}
+}
+
+func dataSourceQuotaRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tflog.Debug(ctx, "beginning read", map[string]interface{}{
+		"id": "exoscale_quota",
+	})
+
+	zone := d.Get(dsQuotaAttrZone).(string)
+
+	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutRead))
+	ctx = exoapi.WithEndpoint(ctx, exoapi.NewReqEndpoint(getEnvironment(meta), zone))
+	defer cancel()
+
+	client := GetComputeClient(meta)
+
+	quotas, err := client.ListQuotas(ctx, zone)
+	if err != nil {
+		return diag.Errorf("unable to list quotas: %s", err)
+	}
+
+	resource := d.Get(dsQuotaAttrResource).(string)
+
+	data := make([]map[string]interface{}, 0, len(quotas))
+	for _, quota := range quotas {
+		if resource != "" && defaultString(quota.Resource, "") != resource {
+			continue
+		}
+
+		data = append(data, map[string]interface{}{
+			dsQuotaAttrLimit:    int(defaultInt64(quota.Limit, 0)),
+			dsQuotaAttrResource: defaultString(quota.Resource, ""),
+			dsQuotaAttrUsage:    int(defaultInt64(quota.Usage, 0)),
+		})
+	}
+
+	if resource != "" && len(data) == 0 {
+		return diag.Errorf("quota for resource %q not found", resource)
+	}
+
+	d.SetId(zone)
+
+	if err := d.Set(dsQuotaAttrQuotas, data); err != nil {
+		return diag.FromErr(err)
+	}
+
+	tflog.Debug(ctx, "read finished successfully", map[string]interface{}{
+		"id": "exoscale_quota",
+	})
+
+	return nil
+}
@@ -0,0 +1,49 @@
+package exoscale
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDataSourceQuota(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+data "exoscale_quota" "test" {
+  zone     = "%s"
+  resource = "unknown-resource"
+}`,
+					testZoneName,
+				),
+				ExpectError: regexp.MustCompile(`quota for resource "unknown-resource" not found`),
+			},
+			{
+				Config: fmt.Sprintf(`
+data "exoscale_quota" "all" {
+  zone = "%s"
+}
+
+data "exoscale_quota" "instance" {
+  zone     = "%s"
+  resource = "instance"
+}`,
+					testZoneName,
+					testZoneName,
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.exoscale_quota.all", dsQuotaAttrQuotas+".#"),
+					resource.TestCheckResourceAttr("data.exoscale_quota.instance", dsQuotaAttrQuotas+".#", "1"),
+					resource.TestCheckResourceAttr("data.exoscale_quota.instance", dsQuotaAttrQuotas+".0."+dsQuotaAttrResource, "instance"),
+					resource.TestCheckResourceAttrSet("data.exoscale_quota.instance", dsQuotaAttrQuotas+".0."+dsQuotaAttrLimit),
+					resource.TestCheckResourceAttrSet("data.exoscale_quota.instance", dsQuotaAttrQuotas+".0."+dsQuotaAttrUsage),
+				),
+			},
+		},
+	})
+}
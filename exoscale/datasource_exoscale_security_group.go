@@ -2,11 +2,14 @@ package exoscale
 
 import (
 	"context"
+	"sort"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
+	egoscale "github.com/exoscale/egoscale/v2"
 	exoapi "github.com/exoscale/egoscale/v2/api"
 )
 
@@ -14,6 +17,7 @@ const (
 	dsSecurityGroupAttrID              = "id"
 	dsSecurityGroupAttrName            = "name"
 	dsSecurityGroupAttrExternalSources = "external_sources"
+	dsSecurityGroupAttrRules           = "rules"
 )
 
 func dataSourceSecurityGroup() *schema.Resource {
@@ -29,7 +33,7 @@ Corresponding resource: [exoscale_security_group](../resources/security_group.md
 				ConflictsWith: []string{dsSecurityGroupAttrName},
 			},
 			dsSecurityGroupAttrName: {
-				Description:   "The name to match (conflicts with `id`)",
+				Description:   "The name to match (conflicts with `id`). Use `default` to reference the account default security group (Security Groups are global: the same group applies to all zones).",
 				Type:          schema.TypeString,
 				Optional:      true,
 				ConflictsWith: []string{dsSecurityGroupAttrID},
@@ -42,6 +46,70 @@ Corresponding resource: [exoscale_security_group](../resources/security_group.md
 				},
 				Description: "The list of external network sources, in [CIDR](https://en.wikipedia.org/wiki/Classless_Inter-Domain_Routing#CIDR_notatio) notation.",
 			},
+			dsSecurityGroupAttrRules: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The rule ID.",
+						},
+						resSecurityGroupRuleAttrNetwork: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The network the rule applies to, in CIDR notation (if any).",
+						},
+						resSecurityGroupRuleAttrDescription: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The rule description.",
+						},
+						resSecurityGroupRuleAttrEndPort: {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The end of the targeted port range (TCP/UDP).",
+						},
+						resSecurityGroupRuleAttrICMPCode: {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The ICMP code (ICMP/ICMPv6).",
+						},
+						resSecurityGroupRuleAttrICMPType: {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The ICMP type (ICMP/ICMPv6).",
+						},
+						resSecurityGroupRuleAttrProtocol: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The network protocol (`TCP`, `UDP`, `ICMP`, `ICMPv6`, ...).",
+						},
+						resSecurityGroupRuleAttrPublicSecurityGroup: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The public security group name the rule applies to (if any).",
+						},
+						resSecurityGroupRuleAttrStartPort: {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The start of the targeted port range (TCP/UDP).",
+						},
+						resSecurityGroupRuleAttrFlowDirection: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The traffic direction (`INGRESS` or `EGRESS`).",
+						},
+						resSecurityGroupRuleAttrUserSecurityGroupID: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The source (for ingress)/destination (for egress) security group ID the rule applies to (if any).",
+						},
+					},
+				},
+				Description: "The security group rules, ingress rules first.",
+			},
 		},
 
 		ReadContext: dataSourceSecurityGroupRead,
@@ -53,7 +121,7 @@ func dataSourceSecurityGroupRead(ctx context.Context, d *schema.ResourceData, me
 		"id": resourceSecurityGroupIDString(d),
 	})
 
-	zone := defaultZone
+	zone := getDefaultZone(meta)
 
 	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutRead))
 	ctx = exoapi.WithEndpoint(ctx, exoapi.NewReqEndpoint(getEnvironment(meta), zone))
@@ -96,9 +164,63 @@ func dataSourceSecurityGroupRead(ctx context.Context, d *schema.ResourceData, me
 		}
 	}
 
+	if err := d.Set(dsSecurityGroupAttrRules, dataSourceSecurityGroupRules(securityGroup.Rules)); err != nil {
+		return diag.FromErr(err)
+	}
+
 	tflog.Debug(ctx, "read finished successfully", map[string]interface{}{
 		"id": resourceSecurityGroupIDString(d),
 	})
 
 	return nil
 }
+
+// dataSourceSecurityGroupRules returns the security group rules as exported by the data source,
+// ingress rules first then sorted by ID so that the list order is stable across reads.
+func dataSourceSecurityGroupRules(rules []*egoscale.SecurityGroupRule) []interface{} {
+	sorted := make([]*egoscale.SecurityGroupRule, len(rules))
+	copy(sorted, rules)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		a, b := defaultString(sorted[i].FlowDirection, ""), defaultString(sorted[j].FlowDirection, "")
+		if a != b {
+			return strings.EqualFold(a, "ingress")
+		}
+		return defaultString(sorted[i].ID, "") < defaultString(sorted[j].ID, "")
+	})
+
+	list := make([]interface{}, 0, len(sorted))
+	for _, rule := range sorted {
+		r := map[string]interface{}{
+			"id":                                  defaultString(rule.ID, ""),
+			resSecurityGroupRuleAttrDescription:   defaultString(rule.Description, ""),
+			resSecurityGroupRuleAttrFlowDirection: strings.ToUpper(defaultString(rule.FlowDirection, "")),
+			resSecurityGroupRuleAttrProtocol: strings.ReplaceAll(
+				strings.ToUpper(defaultString(rule.Protocol, "")),
+				"V6",
+				"v6",
+			),
+			resSecurityGroupRuleAttrPublicSecurityGroup: defaultString(rule.SecurityGroupName, ""),
+			resSecurityGroupRuleAttrUserSecurityGroupID: defaultString(rule.SecurityGroupID, ""),
+		}
+
+		if rule.Network != nil {
+			r[resSecurityGroupRuleAttrNetwork] = rule.Network.String()
+		}
+		if rule.StartPort != nil {
+			r[resSecurityGroupRuleAttrStartPort] = int(*rule.StartPort)
+		}
+		if rule.EndPort != nil {
+			r[resSecurityGroupRuleAttrEndPort] = int(*rule.EndPort)
+		}
+		if rule.ICMPType != nil {
+			r[resSecurityGroupRuleAttrICMPType] = int(*rule.ICMPType)
+		}
+		if rule.ICMPCode != nil {
+			r[resSecurityGroupRuleAttrICMPCode] = int(*rule.ICMPCode)
+		}
+
+		list = append(list, r)
+	}
+
+	return list
+}
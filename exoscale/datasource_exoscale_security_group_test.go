@@ -3,6 +3,7 @@ package exoscale
 import (
 	"errors"
 	"fmt"
+	"net"
 	"regexp"
 	"strings"
 	"testing"
@@ -11,6 +12,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/require"
+
+	egoscale "github.com/exoscale/egoscale/v2"
 )
 
 var (
@@ -67,10 +71,82 @@ data "exoscale_security_group" "by-name" {
 					}),
 				),
 			},
+			{
+				Config: `
+data "exoscale_security_group" "default" {
+  name = "default"
+}`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccDataSourceSecurityGroupAttributes("data.exoscale_security_group.default", testAttrs{
+						dsSecurityGroupAttrID:   validation.ToDiagFunc(validation.IsUUID),
+						dsSecurityGroupAttrName: validateString("default"),
+					}),
+				),
+			},
 		},
 	})
 }
 
+func Test_dataSourceSecurityGroupRules(t *testing.T) {
+	_, network, _ := net.ParseCIDR("10.0.0.0/8")
+
+	rules := dataSourceSecurityGroupRules([]*egoscale.SecurityGroupRule{
+		{
+			ID:            nonEmptyStringPtr("3"),
+			FlowDirection: nonEmptyStringPtr("egress"),
+			Protocol:      nonEmptyStringPtr("icmpv6"),
+			ICMPType:      func() *int64 { v := int64(128); return &v }(),
+			ICMPCode:      func() *int64 { v := int64(0); return &v }(),
+			Network:       network,
+		},
+		{
+			ID:              nonEmptyStringPtr("2"),
+			FlowDirection:   nonEmptyStringPtr("ingress"),
+			Protocol:        nonEmptyStringPtr("tcp"),
+			StartPort:       func() *uint16 { v := uint16(22); return &v }(),
+			EndPort:         func() *uint16 { v := uint16(22); return &v }(),
+			SecurityGroupID: nonEmptyStringPtr("sg"),
+		},
+		{
+			ID:                nonEmptyStringPtr("1"),
+			FlowDirection:     nonEmptyStringPtr("ingress"),
+			Protocol:          nonEmptyStringPtr("udp"),
+			SecurityGroupName: nonEmptyStringPtr("public-nlb-healthcheck-sources"),
+		},
+	})
+
+	require.Len(t, rules, 3)
+	require.Equal(t, map[string]interface{}{
+		"id":                     "1",
+		"description":            "",
+		"type":                   "INGRESS",
+		"protocol":               "UDP",
+		"public_security_group":  "public-nlb-healthcheck-sources",
+		"user_security_group_id": "",
+	}, rules[0])
+	require.Equal(t, map[string]interface{}{
+		"id":                     "2",
+		"description":            "",
+		"type":                   "INGRESS",
+		"protocol":               "TCP",
+		"public_security_group":  "",
+		"user_security_group_id": "sg",
+		"start_port":             22,
+		"end_port":               22,
+	}, rules[1])
+	require.Equal(t, map[string]interface{}{
+		"id":                     "3",
+		"cidr":                   "10.0.0.0/8",
+		"description":            "",
+		"type":                   "EGRESS",
+		"protocol":               "ICMPv6",
+		"public_security_group":  "",
+		"user_security_group_id": "",
+		"icmp_type":              128,
+		"icmp_code":              0,
+	}, rules[2])
+}
+
 func testAccDataSourceSecurityGroupAttributes(ds string, expected testAttrs) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		for name, res := range s.RootModule().Resources {
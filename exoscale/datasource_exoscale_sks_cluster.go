@@ -2,6 +2,8 @@ package exoscale
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -15,6 +17,12 @@ import (
 const (
 	dsSKSClusterIdentifier = "exoscale_sks_cluster"
 	dsSKSClusterID         = "id"
+
+	dsSKSClusterAttrNodepoolDetails             = "nodepool_details"
+	dsSKSClusterAttrNodepoolDetailsID           = "id"
+	dsSKSClusterAttrNodepoolDetailsInstanceType = "instance_type"
+	dsSKSClusterAttrNodepoolDetailsName         = "name"
+	dsSKSClusterAttrNodepoolDetailsSize         = "size"
 )
 
 func dataSourceSKSCluster() *schema.Resource {
@@ -34,6 +42,35 @@ func dataSourceSKSCluster() *schema.Resource {
 				Optional:     true,
 				ExactlyOneOf: []string{resSKSClusterAttrName},
 			},
+			dsSKSClusterAttrNodepoolDetails: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The list of [exoscale_sks_nodepool](./sks_nodepool.md) attached to the cluster, with their main attributes.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						dsSKSClusterAttrNodepoolDetailsID: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The SKS node pool ID.",
+						},
+						dsSKSClusterAttrNodepoolDetailsInstanceType: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The managed compute instances type.",
+						},
+						dsSKSClusterAttrNodepoolDetailsName: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The SKS node pool name.",
+						},
+						dsSKSClusterAttrNodepoolDetailsSize: {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The number of instances in the node pool.",
+						},
+					},
+				},
+			},
 		},
 
 		ReadContext: dataSourceSKSClusterRead,
@@ -113,9 +150,65 @@ func dataSourceSKSClusterRead(ctx context.Context, d *schema.ResourceData, meta
 	d.SetId(*cluster.ID)
 
 	clusterData := clusterToDataMap(cluster)
+
+	if cluster.AddOns != nil {
+		clusterData[resSKSClusterAttrExoscaleCCM] = in(*cluster.AddOns, sksClusterAddonExoscaleCCM)
+		clusterData[resSKSClusterAttrMetricsServer] = in(*cluster.AddOns, sksClusterAddonMS)
+	}
+
+	certificates, err := readClusterCertificates(client.Client, ctx, zone, cluster)
+	if err != nil {
+		return diag.Errorf("error retrieving cluster %q certificates: %s", *cluster.ID, err)
+	}
+	clusterData[resSKSClusterAttrAggregationLayerCA] = certificates.AggregationCA
+	clusterData[resSKSClusterAttrControlPlaneCA] = certificates.ControlPlaneCA
+	clusterData[resSKSClusterAttrKubeletCA] = certificates.KubeletCA
+
+	nodepoolDetails, err := sksClusterNodepoolDetails(ctx, client.Client, zone, cluster)
+	if err != nil {
+		return diag.Errorf("error retrieving cluster %q nodepools: %s", *cluster.ID, err)
+	}
+	clusterData[dsSKSClusterAttrNodepoolDetails] = nodepoolDetails
+
 	if err := general.Apply(clusterData, d, dataSourceSKSCluster().Schema); err != nil {
 		return diag.FromErr(err)
 	}
 
 	return nil
 }
+
+// sksClusterNodepoolDetails returns the summary of the cluster nodepools,
+// resolving their instance type names.
+func sksClusterNodepoolDetails(
+	ctx context.Context,
+	client *v2.Client,
+	zone string,
+	cluster *v2.SKSCluster,
+) ([]interface{}, error) {
+	instanceTypes := make(map[string]string)
+
+	details := make([]interface{}, len(cluster.Nodepools))
+	for i, nodepool := range cluster.Nodepools {
+		instanceTypeID := defaultString(nodepool.InstanceTypeID, "")
+		if _, ok := instanceTypes[instanceTypeID]; !ok && instanceTypeID != "" {
+			instanceType, err := client.GetInstanceType(ctx, zone, instanceTypeID)
+			if err != nil {
+				return nil, fmt.Errorf("unable to retrieve instance type %q: %w", instanceTypeID, err)
+			}
+			instanceTypes[instanceTypeID] = fmt.Sprintf(
+				"%s.%s",
+				strings.ToLower(*instanceType.Family),
+				strings.ToLower(*instanceType.Size),
+			)
+		}
+
+		details[i] = map[string]interface{}{
+			dsSKSClusterAttrNodepoolDetailsID:           defaultString(nodepool.ID, ""),
+			dsSKSClusterAttrNodepoolDetailsInstanceType: instanceTypes[instanceTypeID],
+			dsSKSClusterAttrNodepoolDetailsName:         defaultString(nodepool.Name, ""),
+			dsSKSClusterAttrNodepoolDetailsSize:         int(defaultInt64(nodepool.Size, 0)),
+		}
+	}
+
+	return details, nil
+}
@@ -21,7 +21,12 @@ const (
 )
 
 func dataSourceSKSClusterListGetElementScheme() general.SchemaMap {
-	return dataSourceSKSCluster().Schema
+	elementSchema := dataSourceSKSCluster().Schema
+
+	// Resolving nodepool details requires additional API calls per cluster.
+	delete(elementSchema, dsSKSClusterAttrNodepoolDetails)
+
+	return elementSchema
 }
 
 func dataSourceSKSClusterList() *schema.Resource {
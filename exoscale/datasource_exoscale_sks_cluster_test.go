@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -162,7 +163,12 @@ func TestAccSKSDataSources(t *testing.T) {
 			DataSourceIdentifier: dsId,
 			DataSourceName:       dsName,
 			Attributes: testAttrs{
-				"name": validateString(cluster1Name),
+				"name":                             validateString(cluster1Name),
+				"endpoint":                         validation.ToDiagFunc(validation.NoZeroValues),
+				"nodepool_details.#":               validateString("1"),
+				"nodepool_details.0.name":          validateString(nodepool1Name),
+				"nodepool_details.0.instance_type": validateString("standard.medium"),
+				"nodepool_details.0.size":          validateString("3"),
 			},
 		},
 	}
@@ -0,0 +1,177 @@
+package exoscale
+
+import (
+	"context"
+	"crypto/md5"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	v2 "github.com/exoscale/egoscale/v2"
+	exoapi "github.com/exoscale/egoscale/v2/api"
+	"github.com/exoscale/terraform-provider-exoscale/pkg/filter"
+	"github.com/exoscale/terraform-provider-exoscale/pkg/general"
+)
+
+const (
+	dsTemplateListAttrBootMode  = "boot_mode"
+	dsTemplateListAttrCreatedAt = "created_at"
+	dsTemplateListAttrSize      = "size"
+	dsTemplateListAttrTemplates = "templates"
+)
+
+func dataSourceTemplateList() *schema.Resource {
+	return &schema.Resource{
+		Description: `List Exoscale [Compute Instance Templates](https://community.exoscale.com/documentation/compute/custom-templates/).
+
+Corresponding data source: [exoscale_template](./template.md).`,
+		Schema: map[string]*schema.Schema{
+			dsTemplateAttrZone: {
+				Description: "The Exoscale [Zone](https://www.exoscale.com/datacenters/) name.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			dsTemplateAttrName: {
+				Description: "A template name to match. If you supply a string that begins and ends with a \"/\" it will be matched as a regex (e.g. `/^Linux Ubuntu 22\\.04/`).",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			dsTemplateAttrVisibility: {
+				Description: "A template category filter (default: `public`); among: - `public` - official Exoscale templates - `private` - custom templates private to my organization",
+				Type:        schema.TypeString,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile("(?:public|private)"),
+					`must be either "public" or "private"`),
+				Optional: true,
+				Default:  "public",
+			},
+			dsTemplateListAttrTemplates: {
+				Description: "The list of matching templates, the newest first.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						dsTemplateListAttrBootMode: {
+							Description: "The template boot mode (`legacy` or `uefi`).",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						dsTemplateListAttrCreatedAt: {
+							Description: "The template creation date.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						dsTemplateAttrID: {
+							Description: "The template ID.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						dsTemplateAttrName: {
+							Description: "The template name.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						dsTemplateListAttrSize: {
+							Description: "The template size (bytes).",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+
+		ReadContext: dataSourceTemplateListRead,
+	}
+}
+
+func dataSourceTemplateListRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tflog.Debug(ctx, "beginning read", map[string]interface{}{
+		"id": general.ResourceIDString(d, "exoscale_template_list"),
+	})
+
+	zone := d.Get(dsTemplateAttrZone).(string)
+
+	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutRead))
+	ctx = exoapi.WithEndpoint(ctx, exoapi.NewReqEndpoint(getEnvironment(meta), zone))
+	defer cancel()
+
+	client := GetComputeClient(meta)
+
+	visibility := d.Get(dsTemplateAttrVisibility).(string)
+	res, err := cachedList(meta, "ListTemplates/"+visibility, zone, func() (interface{}, error) {
+		return client.ListTemplates(ctx, zone, v2.ListTemplatesWithVisibility(visibility))
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	templates := res.([]*v2.Template)
+
+	filters, err := filter.CreateFilters(ctx, d, map[string]*schema.Schema{
+		dsTemplateAttrName: {Type: schema.TypeString},
+	})
+	if err != nil {
+		return diag.Errorf("failed to create filter: %q", err)
+	}
+
+	data := dataSourceTemplateListBuild(templates, filters)
+	if err := d.Set(dsTemplateListAttrTemplates, data); err != nil {
+		return diag.FromErr(err)
+	}
+
+	ids := make([]string, 0, len(data))
+	for _, template := range data {
+		ids = append(ids, template.(map[string]interface{})[dsTemplateAttrID].(string))
+	}
+	sort.Strings(ids)
+
+	d.SetId(fmt.Sprintf("%x", md5.Sum([]byte(zone+strings.Join(ids, "")))))
+
+	tflog.Debug(ctx, "read finished successfully", map[string]interface{}{
+		"id": general.ResourceIDString(d, "exoscale_template_list"),
+	})
+
+	return nil
+}
+
+// dataSourceTemplateListBuild returns the templates matching all filters, the newest first,
+// so that the first element is the latest revision of a template matched by name.
+func dataSourceTemplateListBuild(templates []*v2.Template, filters []filter.FilterFunc) []interface{} {
+	sorted := make([]*v2.Template, 0, len(templates))
+	for _, template := range templates {
+		if template.ID == nil {
+			continue
+		}
+		sorted = append(sorted, template)
+	}
+	sort.SliceStable(sorted, func(i, j int) bool {
+		a, b := sorted[i].CreatedAt, sorted[j].CreatedAt
+		return a != nil && (b == nil || a.After(*b))
+	})
+
+	data := make([]interface{}, 0, len(sorted))
+	for _, template := range sorted {
+		templateData := map[string]interface{}{
+			dsTemplateListAttrBootMode: defaultString(template.BootMode, ""),
+			dsTemplateAttrID:           *template.ID,
+			dsTemplateAttrName:         defaultString(template.Name, ""),
+			dsTemplateListAttrSize:     int(defaultInt64(template.Size, 0)),
+		}
+		if template.CreatedAt != nil {
+			templateData[dsTemplateListAttrCreatedAt] = template.CreatedAt.String()
+		}
+
+		if !filter.CheckForMatch(templateData, filters) {
+			continue
+		}
+
+		data = append(data, templateData)
+	}
+
+	return data
+}
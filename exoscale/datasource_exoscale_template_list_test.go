@@ -0,0 +1,83 @@
+package exoscale
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/require"
+
+	v2 "github.com/exoscale/egoscale/v2"
+	"github.com/exoscale/terraform-provider-exoscale/pkg/filter"
+)
+
+func TestAccDataSourceTemplateList(t *testing.T) {
+	ds := "data.exoscale_template_list.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+data "exoscale_template_list" "test" {
+  zone = "%s"
+  name = "/^%s$/"
+}
+`,
+					testZoneName,
+					regexp.QuoteMeta(testInstanceTemplateName),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrWith(ds, "templates.#", func(v string) error {
+						if v == "0" {
+							return fmt.Errorf("expected at least one template named %q", testInstanceTemplateName)
+						}
+						return nil
+					}),
+					testAccDataSourceTemplateAttributes(ds, testAttrs{
+						"templates.0.id":   validation.ToDiagFunc(validation.IsUUID),
+						"templates.0.name": validateString(testInstanceTemplateName),
+					}),
+				),
+			},
+		},
+	})
+}
+
+func Test_dataSourceTemplateListBuild(t *testing.T) {
+	newer := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+	older := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	templates := []*v2.Template{
+		{ID: nonEmptyStringPtr("1"), Name: nonEmptyStringPtr("Linux Ubuntu 22.04 LTS 64-bit"), CreatedAt: &older},
+		{ID: nonEmptyStringPtr("2"), Name: nonEmptyStringPtr("Linux Debian 12 (Bookworm) 64-bit"), CreatedAt: &newer},
+		{ID: nonEmptyStringPtr("3"), Name: nonEmptyStringPtr("Linux Ubuntu 22.04 LTS 64-bit"), CreatedAt: &newer},
+		{Name: nonEmptyStringPtr("no ID")},
+	}
+
+	ids := func(data []interface{}) []string {
+		list := make([]string, len(data))
+		for i, template := range data {
+			list[i] = template.(map[string]interface{})[dsTemplateAttrID].(string)
+		}
+		return list
+	}
+
+	require.Equal(t, []string{"2", "3", "1"}, ids(dataSourceTemplateListBuild(templates, nil)))
+
+	d := schema.TestResourceDataRaw(t, dataSourceTemplateList().Schema, map[string]interface{}{
+		dsTemplateAttrZone: testZoneName,
+		dsTemplateAttrName: `/^Linux Ubuntu 22\.04/`,
+	})
+	filters, err := filter.CreateFilters(context.Background(), d, map[string]*schema.Schema{
+		dsTemplateAttrName: {Type: schema.TypeString},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"3", "1"}, ids(dataSourceTemplateListBuild(templates, filters)))
+}
@@ -12,15 +12,19 @@ import (
 	"time"
 
 	retryablehttp "github.com/hashicorp/go-retryablehttp"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/logging"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	ini "gopkg.in/ini.v1"
 
 	"github.com/exoscale/terraform-provider-exoscale/pkg/config"
 	"github.com/exoscale/terraform-provider-exoscale/pkg/resources/anti_affinity_group"
 	"github.com/exoscale/terraform-provider-exoscale/pkg/resources/instance"
 	"github.com/exoscale/terraform-provider-exoscale/pkg/resources/instance_pool"
+	"github.com/exoscale/terraform-provider-exoscale/pkg/resources/snapshot"
+	"github.com/exoscale/terraform-provider-exoscale/pkg/utils"
 
 	"github.com/exoscale/egoscale"
 	exov2 "github.com/exoscale/egoscale/v2"
@@ -53,9 +57,44 @@ func init() {
 	}
 }
 
+// ProviderServer returns the Exoscale Provider gRPC server, reporting the
+// explanations of the planned resources replacements as warning diagnostics.
+func ProviderServer() tfprotov5.ProviderServer {
+	return &replacementWarningsServer{ProviderServer: Provider().GRPCProvider()}
+}
+
+// replacementWarningsServer is a tfprotov5.ProviderServer adding the explanations
+// recorded by utils.WarnReplacement while planning a resource change to the
+// plan diagnostics, as CustomizeDiff functions cannot return warnings.
+type replacementWarningsServer struct {
+	tfprotov5.ProviderServer
+}
+
+func (s *replacementWarningsServer) PlanResourceChange(
+	ctx context.Context,
+	req *tfprotov5.PlanResourceChangeRequest,
+) (*tfprotov5.PlanResourceChangeResponse, error) {
+	ctx, reasons := utils.WithReplacementWarnings(ctx)
+
+	resp, err := s.ProviderServer.PlanResourceChange(ctx, req)
+	if err != nil || resp == nil {
+		return resp, err
+	}
+
+	for _, reason := range reasons() {
+		resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+			Severity: tfprotov5.DiagnosticSeverityWarning,
+			Summary:  "Resource replacement",
+			Detail:   reason,
+		})
+	}
+
+	return resp, nil
+}
+
 // Provider returns an Exoscale Provider.
 func Provider() *schema.Provider {
-	return &schema.Provider{
+	p := &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"key": {
 				Type:        schema.TypeString,
@@ -101,6 +140,18 @@ func Provider() *schema.Provider {
 			"environment": {
 				Type:     schema.TypeString,
 				Optional: true,
+				Description: fmt.Sprintf(
+					"Exoscale API environment, used to build the zonal API endpoints (by default: %s)",
+					DefaultEnvironment),
+			},
+			"default_zone": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: utils.ValidateZone(),
+				Description: fmt.Sprintf(
+					"Zone used for zone-agnostic operations such as DNS or global resources (Security Groups, SSH keys, "+
+						"Anti-Affinity Groups, IAM) management (by default: %s)",
+					config.DefaultZone),
 			},
 			"timeout": {
 				Type:     schema.TypeFloat,
@@ -109,6 +160,46 @@ func Provider() *schema.Provider {
 					"Timeout in seconds for waiting on compute resources to become available (by default: %.0f)",
 					config.DefaultTimeout.Seconds()),
 			},
+			"max_concurrency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description: "Maximum number of concurrent API requests issued by the provider, " +
+					"regardless of Terraform parallelism (by default: unlimited)",
+			},
+			"dns_max_retries": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+				DefaultFunc: func() (interface{}, error) {
+					return providerConfig.GetDNSMaxRetries()
+				},
+				Description: fmt.Sprintf(
+					"Maximum number of retries of DNS API requests failing with a server or rate-limiting error (by default: %d)",
+					providerConfig.DefaultDNSMaxRetries),
+			},
+			"enable_quota_checks": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Description: "Check the organization quotas when planning the creation of compute instances (including " +
+					"instance pools members), private networks and Elastic IPs, failing at plan time with an explicit " +
+					"error if a quota is exhausted. Each resource is checked on its own: the quota required by several " +
+					"resources planned together (e.g. using `count`) is not summed, and may still be exhausted at apply " +
+					"time (by default: false)",
+			},
+			"enable_list_cache": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Description: "Cache the responses of the list API operations performed by data sources (e.g. networks, Elastic IPs, templates) " +
+					"for the duration of a Terraform run, any write request invalidating the cache (by default: false)",
+			},
+			"read_only": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Description: "Refuse any API request which may modify resources, failing the create, update and delete operations " +
+					"with an explicit error while allowing reads (e.g. to safely run `terraform plan` against production; by default: false)",
+			},
+			"nlb_service_healthcheck_defaults": nlbServiceHealthcheckDefaultsSchema(),
 			"delay": {
 				Type:       schema.TypeInt,
 				Optional:   true,
@@ -117,58 +208,92 @@ func Provider() *schema.Provider {
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
-			"exoscale_affinity":              dataSourceAffinity(),
-			"exoscale_anti_affinity_group":   anti_affinity_group.DataSource(),
-			"exoscale_compute":               dataSourceCompute(),
-			"exoscale_compute_instance":      instance.DataSource(),
-			"exoscale_compute_instance_list": instance.DataSourceList(),
-			"exoscale_compute_ipaddress":     dataSourceComputeIPAddress(),
-			"exoscale_compute_template":      dataSourceComputeTemplate(),
-			"exoscale_domain":                dataSourceDomain(),
-			"exoscale_domain_record":         dataSourceDomainRecord(),
-			"exoscale_elastic_ip":            dataSourceElasticIP(),
-			"exoscale_instance_pool":         instance_pool.DataSource(),
-			"exoscale_instance_pool_list":    instance_pool.DataSourceList(),
-			"exoscale_network":               dataSourceNetwork(),
-			"exoscale_nlb":                   dataSourceNLB(),
-			"exoscale_private_network":       dataSourcePrivateNetwork(),
-			"exoscale_security_group":        dataSourceSecurityGroup(),
-			"exoscale_template":              dataSourceTemplate(),
-			dsSKSClusterIdentifier:           dataSourceSKSCluster(),
-			dsSKSClustersListIdentifier:      dataSourceSKSClusterList(),
-			dsSKSNodepoolsListIdentifier:     dataSourceSKSNodepoolList(),
-			dsSKSNodepoolIdentifier:          dataSourceSKSNodepool(),
+			"exoscale_affinity":                    dataSourceAffinity(),
+			"exoscale_anti_affinity_group":         anti_affinity_group.DataSource(),
+			"exoscale_anti_affinity_group_members": anti_affinity_group.DataSourceMembers(),
+			"exoscale_compute":                     dataSourceCompute(),
+			"exoscale_compute_instance":            instance.DataSource(),
+			"exoscale_compute_instance_list":       instance.DataSourceList(),
+			"exoscale_compute_ipaddress":           dataSourceComputeIPAddress(),
+			"exoscale_compute_template":            dataSourceComputeTemplate(),
+			"exoscale_domain":                      dataSourceDomain(),
+			"exoscale_domain_record":               dataSourceDomainRecord(),
+			"exoscale_elastic_ip":                  dataSourceElasticIP(),
+			"exoscale_elastic_ip_reverse_dns":      dataSourceElasticIPReverseDNS(),
+			"exoscale_iam_caller_identity":         dataSourceIAMCallerIdentity(),
+			"exoscale_instance_pool":               instance_pool.DataSource(),
+			"exoscale_instance_pool_list":          instance_pool.DataSourceList(),
+			"exoscale_network":                     dataSourceNetwork(),
+			"exoscale_nlb":                         dataSourceNLB(),
+			"exoscale_private_network":             dataSourcePrivateNetwork(),
+			"exoscale_quota":                       dataSourceQuota(),
+			"exoscale_security_group":              dataSourceSecurityGroup(),
+			"exoscale_template":                    dataSourceTemplate(),
+			"exoscale_template_list":               dataSourceTemplateList(),
+			dsSKSClusterIdentifier:                 dataSourceSKSCluster(),
+			dsSKSClustersListIdentifier:            dataSourceSKSClusterList(),
+			dsSKSNodepoolsListIdentifier:           dataSourceSKSNodepoolList(),
+			dsSKSNodepoolIdentifier:                dataSourceSKSNodepool(),
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
-			"exoscale_affinity":             resourceAffinity(),
-			"exoscale_anti_affinity_group":  anti_affinity_group.Resource(),
-			"exoscale_compute":              resourceCompute(),
-			"exoscale_compute_instance":     instance.Resource(),
-			"exoscale_domain":               resourceDomain(),
-			"exoscale_domain_record":        resourceDomainRecord(),
-			"exoscale_elastic_ip":           resourceElasticIP(),
-			"exoscale_iam_access_key":       resourceIAMAccessKey(),
-			"exoscale_instance_pool":        instance_pool.Resource(),
-			"exoscale_ipaddress":            resourceIPAddress(),
-			"exoscale_network":              resourceNetwork(),
-			"exoscale_nic":                  resourceNIC(),
-			"exoscale_nlb":                  resourceNLB(),
-			"exoscale_nlb_service":          resourceNLBService(),
-			"exoscale_private_network":      resourcePrivateNetwork(),
-			"exoscale_secondary_ipaddress":  resourceSecondaryIPAddress(),
-			"exoscale_security_group":       resourceSecurityGroup(),
-			"exoscale_security_group_rule":  resourceSecurityGroupRule(),
-			"exoscale_security_group_rules": resourceSecurityGroupRules(),
-			"exoscale_sks_cluster":          resourceSKSCluster(),
-			"exoscale_sks_kubeconfig":       resourceSKSKubeconfig(),
-			"exoscale_sks_nodepool":         resourceSKSNodepool(),
-			"exoscale_ssh_key":              resourceSSHKey(),
-			"exoscale_ssh_keypair":          resourceSSHKeypair(),
+			"exoscale_affinity":               resourceAffinity(),
+			"exoscale_anti_affinity_group":    anti_affinity_group.Resource(),
+			"exoscale_compute":                resourceCompute(),
+			"exoscale_compute_instance":       instance.Resource(),
+			"exoscale_dns_domain":             resourceDomain(),
+			"exoscale_dns_record":             resourceDomainRecord(),
+			"exoscale_dns_records":            resourceDNSRecords(),
+			"exoscale_domain":                 deprecatedResourceAlias(resourceDomain(), "exoscale_dns_domain", "dns_domain"),
+			"exoscale_domain_record":          deprecatedResourceAlias(resourceDomainRecord(), "exoscale_dns_record", "dns_record"),
+			"exoscale_elastic_ip":             resourceElasticIP(),
+			"exoscale_elastic_ip_association": resourceElasticIPAssociation(),
+			"exoscale_iam_access_key":         resourceIAMAccessKey(),
+			"exoscale_iam_org_policy":         resourceIAMOrgPolicy(),
+			"exoscale_instance_pool":          instance_pool.Resource(),
+			"exoscale_ipaddress":              resourceIPAddress(),
+			"exoscale_network":                resourceNetwork(),
+			"exoscale_nic":                    resourceNIC(),
+			"exoscale_nlb":                    resourceNLB(),
+			"exoscale_nlb_service":            resourceNLBService(),
+			"exoscale_private_network":        resourcePrivateNetwork(),
+			"exoscale_secondary_ipaddress":    resourceSecondaryIPAddress(),
+			"exoscale_security_group":         resourceSecurityGroup(),
+			"exoscale_security_group_rule":    resourceSecurityGroupRule(),
+			"exoscale_security_group_rules":   resourceSecurityGroupRules(),
+			"exoscale_sks_cluster":            resourceSKSCluster(),
+			"exoscale_sks_kubeconfig":         resourceSKSKubeconfig(),
+			"exoscale_sks_nodepool":           resourceSKSNodepool(),
+			"exoscale_snapshot":               snapshot.Resource(),
+			"exoscale_ssh_key":                resourceSSHKey(),
+			"exoscale_ssh_keypair":            resourceSSHKeypair(),
 		},
 
 		ConfigureContextFunc: ProviderConfigure,
 	}
+
+	for _, r := range p.DataSourcesMap {
+		withDeprecationDiagnostics(r)
+	}
+	for _, r := range p.ResourcesMap {
+		withDeprecationDiagnostics(r)
+	}
+
+	return p
+}
+
+// deprecatedResourceAlias returns the resource r registered under a former name,
+// deprecated in favor of the resource name (documented in the doc page).
+// Existing state is not moved to the new resource type, as SDKv2 resources don't support
+// cross-type moves: the doc page documents the manual migration instead.
+func deprecatedResourceAlias(r *schema.Resource, name, doc string) *schema.Resource {
+	r.DeprecationMessage = fmt.Sprintf(
+		"!> **WARNING:** This resource is **DEPRECATED** and will be removed in the next major version. Please use [%s](./%s.md) instead.",
+		name,
+		doc,
+	)
+
+	return r
 }
 
 type Configuration struct {
@@ -269,7 +394,9 @@ func CreateClient(baseConfig *providerConfig.BaseConfig) (*exov2.Client, error)
 		exov2.ClientOptWithHTTPClient(func() *http.Client {
 			rc := retryablehttp.NewClient()
 			rc.Logger = LeveledTFLogger{Verbose: logging.IsDebugOrHigher()}
+			rc.HTTPClient.Transport = invalidateListCache(*baseConfig, warnDeprecations(limitConcurrency(*baseConfig, rc.HTTPClient.Transport)))
 			hc := rc.StandardClient()
+			hc.Transport = enforceReadOnly(*baseConfig, hc.Transport)
 			if logging.IsDebugOrHigher() {
 				hc.Transport = logging.NewSubsystemLoggingHTTPTransport("exoscale", hc.Transport)
 			}
@@ -334,6 +461,11 @@ func ProviderConfigure(_ context.Context, d *schema.ResourceData) (interface{},
 			DefaultEnvironment)
 	}
 
+	defaultZone, defaultZoneOK := d.GetOk("default_zone")
+	if !defaultZoneOK {
+		defaultZone = providerConfig.GetDefaultZone()
+	}
+
 	// deprecation support
 	token, tokenOK := d.GetOk("token")
 	if tokenOK && !keyOK {
@@ -415,6 +547,71 @@ func ProviderConfigure(_ context.Context, d *schema.ResourceData) (interface{},
 		}
 	}
 
+	var maxConcurrency int
+	maxConcurrencyRaw, maxConcurrencyOk := d.GetOk("max_concurrency")
+	if maxConcurrencyOk {
+		maxConcurrency = maxConcurrencyRaw.(int)
+	} else {
+		var err error
+		maxConcurrency, err = providerConfig.GetMaxConcurrency()
+
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+	}
+
+	// The default value (environment or DefaultDNSMaxRetries) is set by the schema,
+	// allowing to disable the retries with an explicit 0.
+	dnsMaxRetries := d.Get("dns_max_retries").(int)
+
+	var enableQuotaChecks bool
+	enableQuotaChecksRaw, enableQuotaChecksOk := d.GetOk("enable_quota_checks")
+	if enableQuotaChecksOk {
+		enableQuotaChecks = enableQuotaChecksRaw.(bool)
+	} else {
+		var err error
+		enableQuotaChecks, err = providerConfig.GetEnableQuotaChecks()
+
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+	}
+
+	var enableListCache bool
+	enableListCacheRaw, enableListCacheOk := d.GetOk("enable_list_cache")
+	if enableListCacheOk {
+		enableListCache = enableListCacheRaw.(bool)
+	} else {
+		var err error
+		enableListCache, err = providerConfig.GetEnableListCache()
+
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+	}
+
+	var readOnly bool
+	readOnlyRaw, readOnlyOk := d.GetOk("read_only")
+	if readOnlyOk {
+		readOnly = readOnlyRaw.(bool)
+	} else {
+		var err error
+		readOnly, err = providerConfig.GetReadOnly()
+
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+	}
+
+	var nlbServiceHealthcheckDefaults map[string]interface{}
+	if l := d.Get("nlb_service_healthcheck_defaults").([]interface{}); len(l) > 0 && l[0] != nil {
+		nlbServiceHealthcheckDefaults = l[0].(map[string]interface{})
+
+		if err := validateNLBServiceHealthcheck(nlbServiceDefaultHealthcheck(nlbServiceHealthcheckDefaults, 0)); err != nil {
+			return nil, diag.Errorf("invalid nlb_service_healthcheck_defaults: %s", err)
+		}
+	}
+
 	baseConfig := providerConfig.BaseConfig{
 		Key:             key.(string),
 		Secret:          secret.(string),
@@ -422,6 +619,13 @@ func ProviderConfigure(_ context.Context, d *schema.ResourceData) (interface{},
 		ComputeEndpoint: endpoint.(string),
 		DNSEndpoint:     dnsEndpoint.(string),
 		Environment:     environment.(string),
+		DefaultZone:     defaultZone.(string),
+		MaxConcurrency:  maxConcurrency,
+		DNSMaxRetries:   dnsMaxRetries,
+		QuotaChecks:     enableQuotaChecks,
+		APISemaphore:    providerConfig.SharedAPISemaphore(key.(string), maxConcurrency),
+		ListCache:       providerConfig.SharedListCache(key.(string), enableListCache),
+		ReadOnly:        readOnly,
 	}
 
 	clv2, err := CreateClient(&baseConfig)
@@ -430,9 +634,13 @@ func ProviderConfigure(_ context.Context, d *schema.ResourceData) (interface{},
 	}
 
 	return map[string]interface{}{
-			"config":      baseConfig,
-			"client":      clv2,
-			"environment": environment,
+			"config":                           baseConfig,
+			"client":                           clv2,
+			"environment":                      environment,
+			"default_zone":                     defaultZone,
+			"quota_checks":                     enableQuotaChecks,
+			"template_cache":                   config.NewTemplateCache(config.TemplateCacheTTL),
+			"nlb_service_healthcheck_defaults": nlbServiceHealthcheckDefaults,
 		},
 		diags
 }
@@ -9,10 +9,14 @@ import (
 	"testing"
 
 	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/require"
+
+	"github.com/exoscale/terraform-provider-exoscale/pkg/utils"
 )
 
 // Common test environment information
@@ -61,6 +65,28 @@ func TestProvider(t *testing.T) {
 	}
 }
 
+func TestProviderDNSResourceAliases(t *testing.T) {
+	resources := Provider().ResourcesMap
+
+	for alias, name := range map[string]string{
+		"exoscale_domain":        "exoscale_dns_domain",
+		"exoscale_domain_record": "exoscale_dns_record",
+	} {
+		if resources[name].DeprecationMessage != "" {
+			t.Errorf("%s: unexpected deprecation message", name)
+		}
+		if !strings.Contains(resources[alias].DeprecationMessage, name) {
+			t.Errorf("%s: expected a deprecation message pointing to %s, got %q",
+				alias, name, resources[alias].DeprecationMessage)
+		}
+		aliasSchema, nameSchema := resources[alias].CoreConfigSchema(), resources[name].CoreConfigSchema()
+		if !reflect.DeepEqual(aliasSchema.Attributes, nameSchema.Attributes) ||
+			!reflect.DeepEqual(aliasSchema.BlockTypes, nameSchema.BlockTypes) {
+			t.Errorf("%s: schema differs from %s", alias, name)
+		}
+	}
+}
+
 func testAccPreCheck(t *testing.T) {
 	key := os.Getenv("EXOSCALE_API_KEY")
 	secret := os.Getenv("EXOSCALE_API_SECRET")
@@ -250,3 +276,50 @@ func Test_zonedStateContextFunc(t *testing.T) {
 		})
 	}
 }
+
+// testPlanProviderServer is a tfprotov5.ProviderServer planning a replacement.
+type testPlanProviderServer struct {
+	tfprotov5.ProviderServer
+
+	impacts map[string]string
+	diff    utils.ReplacementDiff
+}
+
+func (s *testPlanProviderServer) PlanResourceChange(
+	ctx context.Context,
+	_ *tfprotov5.PlanResourceChangeRequest,
+) (*tfprotov5.PlanResourceChangeResponse, error) {
+	utils.WarnReplacement(ctx, s.diff, s.impacts)
+
+	return &tfprotov5.PlanResourceChangeResponse{}, nil
+}
+
+type testReplacementDiff struct {
+	id      string
+	changed []string
+}
+
+func (d testReplacementDiff) Id() string { return d.id }
+
+func (d testReplacementDiff) HasChange(key string) bool { return in(d.changed, key) }
+
+func Test_replacementWarningsServer(t *testing.T) {
+	server := &replacementWarningsServer{ProviderServer: &testPlanProviderServer{
+		impacts: map[string]string{"zone": "the network is deleted"},
+		diff:    testReplacementDiff{id: "x", changed: []string{"zone", "name"}},
+	}}
+
+	resp, err := server.PlanResourceChange(context.Background(), &tfprotov5.PlanResourceChangeRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []*tfprotov5.Diagnostic{{
+		Severity: tfprotov5.DiagnosticSeverityWarning,
+		Summary:  "Resource replacement",
+		Detail:   "changing zone forces the replacement of the resource: the network is deleted",
+	}}, resp.Diagnostics)
+
+	// In-place changes
+	server.ProviderServer.(*testPlanProviderServer).diff = testReplacementDiff{id: "x", changed: []string{"name"}}
+	resp, err = server.PlanResourceChange(context.Background(), &tfprotov5.PlanResourceChangeRequest{})
+	require.NoError(t, err)
+	require.Empty(t, resp.Diagnostics)
+}
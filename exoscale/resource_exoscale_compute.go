@@ -29,10 +29,11 @@ func resourceComputeIDString(d general.ResourceIDStringer) string {
 func resourceCompute() *schema.Resource {
 	s := map[string]*schema.Schema{
 		"zone": {
-			Type:        schema.TypeString,
-			Required:    true,
-			ForceNew:    true,
-			Description: "The Exoscale Zone name.",
+			Type:             schema.TypeString,
+			Required:         true,
+			ForceNew:         true,
+			Description:      "The Exoscale Zone name.",
+			ValidateDiagFunc: utils.ValidateZone(),
 		},
 		"template": {
 			Type:          schema.TypeString,
@@ -229,6 +230,8 @@ func resourceCompute() *schema.Resource {
 		Delete: resourceComputeDelete,
 		Exists: resourceComputeExists,
 
+		CustomizeDiff: utils.QuotaCustomizeDiff("instance"),
+
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceComputeImport,
 		},
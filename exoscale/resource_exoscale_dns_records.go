@@ -0,0 +1,466 @@
+package exoscale
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	exo "github.com/exoscale/egoscale/v2"
+	exoapi "github.com/exoscale/egoscale/v2/api"
+	"github.com/exoscale/terraform-provider-exoscale/pkg/config"
+	"github.com/exoscale/terraform-provider-exoscale/pkg/general"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	resDNSRecordsAttrDomain      = "domain"
+	resDNSRecordsAttrManagedOnly = "managed_only"
+	resDNSRecordsAttrRecord      = "record"
+
+	defaultDNSRecordsTTL = 3600
+)
+
+func resourceDNSRecordsIDString(d general.ResourceIDStringer) string {
+	return general.ResourceIDString(d, "exoscale_dns_records")
+}
+
+func resourceDNSRecords() *schema.Resource {
+	return &schema.Resource{
+		Description: `Manage all the records of an Exoscale [DNS](https://community.exoscale.com/documentation/dns/) Domain at once.
+
+The records of the domain are reconciled with the ` + "`record`" + ` blocks at every apply: missing records are created,
+records whose TTL or priority differ are updated and, unless ` + "`managed_only`" + ` is set, records which are not
+declared are deleted (except the ` + "`SOA`" + ` and root ` + "`NS`" + ` records managed by Exoscale). Destroying the resource
+only deletes the declared records.
+
+!> **WARNING:** Records of a domain must not be managed both by this resource (without ` + "`managed_only`" + `)
+and by [exoscale_dns_record](./dns_record.md) resources, or they will delete each other's records.`,
+		Schema: map[string]*schema.Schema{
+			resDNSRecordsAttrDomain: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The [exoscale_dns_domain](./dns_domain.md) (ID) to manage the records of.",
+			},
+			resDNSRecordsAttrManagedOnly: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Only delete the records previously declared in this resource, leaving the other records of the domain untouched (boolean; default: `false`).",
+			},
+			resDNSRecordsAttrRecord: {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"content": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The record value.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The record name, Leave blank (`\"\"`) for a root record (similar to using `@` in a DNS zone file).",
+						},
+						"prio": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      0,
+							ValidateFunc: validation.IntAtLeast(0),
+							Description:  "The record priority (for types that support it; minimum `0`; default: `0`).",
+						},
+						"record_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(supportedRecordTypes, false),
+							Description:  "The record type (`A`, `AAAA`, `ALIAS`, `CAA`, `CNAME`, `HINFO`, `MX`, `NAPTR`, `NS`, `POOL`, `SPF`, `SRV`, `SSHFP`, `TXT`, `URL`).",
+						},
+						"ttl": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      defaultDNSRecordsTTL,
+							ValidateFunc: validation.IntAtLeast(0),
+							Description:  fmt.Sprintf("The record TTL (seconds; minimum `0`; default: `%d`).", defaultDNSRecordsTTL),
+						},
+					},
+				},
+				Description: "A record of the domain (several records may share the same name and type, as long as their content differs).",
+			},
+		},
+
+		CreateContext: resourceDNSRecordsCreate,
+		ReadContext:   resourceDNSRecordsRead,
+		UpdateContext: resourceDNSRecordsUpdate,
+		DeleteContext: resourceDNSRecordsDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceDNSRecordsImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(config.DefaultTimeout),
+			Read:   schema.DefaultTimeout(config.DefaultTimeout),
+			Update: schema.DefaultTimeout(config.DefaultTimeout),
+			Delete: schema.DefaultTimeout(config.DefaultTimeout),
+		},
+	}
+}
+
+// dnsRecordsEntry is a record declared in a exoscale_dns_records resource.
+type dnsRecordsEntry struct {
+	Name    string
+	Type    string
+	Content string
+	TTL     int64
+	Prio    int64
+}
+
+// key identifies a record within a domain: records may share the same name
+// and type (e.g. several A records for round-robin), but not the same content.
+func (e dnsRecordsEntry) key() string {
+	return strings.Join([]string{e.Name, strings.ToUpper(e.Type), e.Content}, "\x00")
+}
+
+func dnsRecordsEntryFromRecord(record exo.DNSDomainRecord) dnsRecordsEntry {
+	return dnsRecordsEntry{
+		Name:    defaultString(record.Name, ""),
+		Type:    strings.ToUpper(defaultString(record.Type, "")),
+		Content: defaultString(record.Content, ""),
+		TTL:     defaultInt64(record.TTL, 0),
+		Prio:    defaultInt64(record.Priority, 0),
+	}
+}
+
+// dnsRecordsEntries returns the records declared in the specified record set.
+func dnsRecordsEntries(v interface{}) []dnsRecordsEntry {
+	set, ok := v.(*schema.Set)
+	if !ok {
+		return nil
+	}
+
+	entries := make([]dnsRecordsEntry, 0, set.Len())
+	for _, r := range set.List() {
+		r := r.(map[string]interface{})
+		entries = append(entries, dnsRecordsEntry{
+			Name:    r["name"].(string),
+			Type:    strings.ToUpper(r["record_type"].(string)),
+			Content: r["content"].(string),
+			TTL:     int64(r["ttl"].(int)),
+			Prio:    int64(r["prio"].(int)),
+		})
+	}
+
+	return entries
+}
+
+// dnsRecordsSystemRecord returns true if the record is managed by Exoscale
+// (SOA and root NS records), and must be left untouched.
+func dnsRecordsSystemRecord(record exo.DNSDomainRecord) bool {
+	switch strings.ToUpper(defaultString(record.Type, "")) {
+	case "SOA":
+		return true
+	case "NS":
+		return defaultString(record.Name, "") == ""
+	}
+
+	return false
+}
+
+// dnsRecordsChanges computes the changes required to reconcile the existing records
+// of a domain with the desired ones: the records to create, the (existing) records
+// to update and the (existing) records to delete. With managedOnly, only the
+// existing records matching the previously managed ones may be deleted.
+func dnsRecordsChanges(
+	existing []exo.DNSDomainRecord,
+	desired []dnsRecordsEntry,
+	managed []dnsRecordsEntry,
+	managedOnly bool,
+) (create []dnsRecordsEntry, update, remove []exo.DNSDomainRecord) {
+	wanted := make(map[string]dnsRecordsEntry, len(desired))
+	for _, e := range desired {
+		wanted[e.key()] = e
+	}
+
+	previous := make(map[string]bool, len(managed))
+	for _, e := range managed {
+		previous[e.key()] = true
+	}
+
+	found := make(map[string]bool, len(existing))
+	for _, record := range existing {
+		if dnsRecordsSystemRecord(record) {
+			continue
+		}
+
+		current := dnsRecordsEntryFromRecord(record)
+		key := current.key()
+
+		if e, ok := wanted[key]; ok && !found[key] {
+			found[key] = true
+			if current.TTL != e.TTL || current.Prio != e.Prio {
+				ttl, prio := e.TTL, e.Prio
+				record.TTL, record.Priority = &ttl, &prio
+				update = append(update, record)
+			}
+			continue
+		}
+
+		// Records not (or no longer) declared, including duplicates of declared records.
+		if !managedOnly || previous[key] {
+			remove = append(remove, record)
+		}
+	}
+
+	for _, e := range desired {
+		if !found[e.key()] {
+			found[e.key()] = true
+			create = append(create, e)
+		}
+	}
+
+	return create, update, remove
+}
+
+// dnsRecordsState returns the records to store in the state: all the records of
+// the domain but the system ones or, with managedOnly, the existing records
+// matching the previously managed ones.
+func dnsRecordsState(existing []exo.DNSDomainRecord, managed []dnsRecordsEntry, managedOnly bool) []interface{} {
+	previous := make(map[string]bool, len(managed))
+	for _, e := range managed {
+		previous[e.key()] = true
+	}
+
+	entries := make([]dnsRecordsEntry, 0, len(existing))
+	seen := make(map[string]bool, len(existing))
+	for _, record := range existing {
+		if dnsRecordsSystemRecord(record) {
+			continue
+		}
+
+		e := dnsRecordsEntryFromRecord(record)
+		if seen[e.key()] || (managedOnly && !previous[e.key()]) {
+			continue
+		}
+		seen[e.key()] = true
+		entries = append(entries, e)
+	}
+
+	sort.Slice(entries, func(i, j int) bool { return entries[i].key() < entries[j].key() })
+
+	data := make([]interface{}, 0, len(entries))
+	for _, e := range entries {
+		data = append(data, map[string]interface{}{
+			"content":     e.Content,
+			"name":        e.Name,
+			"prio":        int(e.Prio),
+			"record_type": e.Type,
+			"ttl":         int(e.TTL),
+		})
+	}
+
+	return data
+}
+
+func resourceDNSRecordsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tflog.Debug(ctx, "beginning create", map[string]interface{}{
+		"id": resourceDNSRecordsIDString(d),
+	})
+
+	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutCreate))
+	defer cancel()
+
+	if err := resourceDNSRecordsApply(ctx, d, meta, nil); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(d.Get(resDNSRecordsAttrDomain).(string))
+
+	tflog.Debug(ctx, "create finished successfully", map[string]interface{}{
+		"id": resourceDNSRecordsIDString(d),
+	})
+
+	return resourceDNSRecordsRead(ctx, d, meta)
+}
+
+func resourceDNSRecordsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tflog.Debug(ctx, "beginning read", map[string]interface{}{
+		"id": resourceDNSRecordsIDString(d),
+	})
+
+	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutRead))
+	ctx = exoapi.WithEndpoint(ctx, exoapi.NewReqEndpoint(getEnvironment(meta), getDefaultZone(meta)))
+	defer cancel()
+
+	client := getDNSAPI(meta)
+
+	records, err := client.ListDNSDomainRecords(ctx, getDefaultZone(meta), d.Id())
+	if err != nil {
+		if errors.Is(err, exoapi.ErrNotFound) {
+			// Resource doesn't exist anymore, signaling the core to remove it from the state.
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("error retrieving domain records: %s", err)
+	}
+
+	state := dnsRecordsState(
+		records,
+		dnsRecordsEntries(d.Get(resDNSRecordsAttrRecord)),
+		d.Get(resDNSRecordsAttrManagedOnly).(bool),
+	)
+
+	if err := d.Set(resDNSRecordsAttrDomain, d.Id()); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(resDNSRecordsAttrRecord, state); err != nil {
+		return diag.FromErr(err)
+	}
+
+	tflog.Debug(ctx, "read finished successfully", map[string]interface{}{
+		"id": resourceDNSRecordsIDString(d),
+	})
+
+	return nil
+}
+
+func resourceDNSRecordsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tflog.Debug(ctx, "beginning update", map[string]interface{}{
+		"id": resourceDNSRecordsIDString(d),
+	})
+
+	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutUpdate))
+	defer cancel()
+
+	old, _ := d.GetChange(resDNSRecordsAttrRecord)
+	if err := resourceDNSRecordsApply(ctx, d, meta, dnsRecordsEntries(old)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	tflog.Debug(ctx, "update finished successfully", map[string]interface{}{
+		"id": resourceDNSRecordsIDString(d),
+	})
+
+	return resourceDNSRecordsRead(ctx, d, meta)
+}
+
+// resourceDNSRecordsDelete deletes the records declared in the resource, the
+// other records of the domain being left untouched.
+func resourceDNSRecordsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tflog.Debug(ctx, "beginning delete", map[string]interface{}{
+		"id": resourceDNSRecordsIDString(d),
+	})
+
+	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutDelete))
+	ctx = exoapi.WithEndpoint(ctx, exoapi.NewReqEndpoint(getEnvironment(meta), getDefaultZone(meta)))
+	defer cancel()
+
+	client := getDNSAPI(meta)
+
+	records, err := client.ListDNSDomainRecords(ctx, getDefaultZone(meta), d.Id())
+	if err != nil {
+		if errors.Is(err, exoapi.ErrNotFound) {
+			// The domain (and thus its records) has already been deleted.
+			return nil
+		}
+		return diag.Errorf("error retrieving domain records: %s", err)
+	}
+
+	_, _, remove := dnsRecordsChanges(records, nil, dnsRecordsEntries(d.Get(resDNSRecordsAttrRecord)), true)
+	for i := range remove {
+		if err := client.DeleteDNSDomainRecord(ctx, getDefaultZone(meta), d.Id(), &remove[i]); err != nil && !errors.Is(err, exoapi.ErrNotFound) {
+			return diag.Errorf("error deleting domain record: %s", err)
+		}
+	}
+
+	tflog.Debug(ctx, "delete finished successfully", map[string]interface{}{
+		"id": resourceDNSRecordsIDString(d),
+	})
+
+	return nil
+}
+
+// resourceDNSRecordsImport imports the records of a domain specified by ID or name.
+func resourceDNSRecordsImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	ctx = exoapi.WithEndpoint(ctx, exoapi.NewReqEndpoint(getEnvironment(meta), getDefaultZone(meta)))
+
+	client := getDNSAPI(meta)
+
+	domains, err := client.ListDNSDomains(ctx, getDefaultZone(meta))
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving domains: %w", err)
+	}
+
+	for _, domain := range domains {
+		if *domain.ID == d.Id() || *domain.UnicodeName == d.Id() {
+			d.SetId(*domain.ID)
+			if err := d.Set(resDNSRecordsAttrDomain, *domain.ID); err != nil {
+				return nil, err
+			}
+			return []*schema.ResourceData{d}, nil
+		}
+	}
+
+	return nil, fmt.Errorf("domain %q not found", d.Id())
+}
+
+// resourceDNSRecordsApply reconciles the records of the domain with the declared
+// ones, managed being the records previously declared in the resource.
+func resourceDNSRecordsApply(ctx context.Context, d *schema.ResourceData, meta interface{}, managed []dnsRecordsEntry) error {
+	ctx = exoapi.WithEndpoint(ctx, exoapi.NewReqEndpoint(getEnvironment(meta), getDefaultZone(meta)))
+
+	client := getDNSAPI(meta)
+
+	domainID := d.Get(resDNSRecordsAttrDomain).(string)
+
+	records, err := client.ListDNSDomainRecords(ctx, getDefaultZone(meta), domainID)
+	if err != nil {
+		return fmt.Errorf("error retrieving domain records: %w", err)
+	}
+
+	create, update, remove := dnsRecordsChanges(
+		records,
+		dnsRecordsEntries(d.Get(resDNSRecordsAttrRecord)),
+		managed,
+		d.Get(resDNSRecordsAttrManagedOnly).(bool),
+	)
+
+	// Delete first, so that records replaced by records conflicting with
+	// them (e.g. a CNAME replacing an A record) can be created.
+	for i := range remove {
+		if err := client.DeleteDNSDomainRecord(ctx, getDefaultZone(meta), domainID, &remove[i]); err != nil {
+			return fmt.Errorf("error deleting domain record %s: %w", *remove[i].ID, err)
+		}
+	}
+
+	for i := range update {
+		if err := client.UpdateDNSDomainRecord(ctx, getDefaultZone(meta), domainID, &update[i]); err != nil {
+			return fmt.Errorf("error updating domain record %s: %w", *update[i].ID, err)
+		}
+	}
+
+	for _, e := range create {
+		e := e
+		record := &exo.DNSDomainRecord{
+			Name:    &e.Name,
+			Type:    &e.Type,
+			Content: &e.Content,
+			TTL:     &e.TTL,
+		}
+		if e.Prio > 0 {
+			record.Priority = &e.Prio
+		}
+		if _, err := client.CreateDNSDomainRecord(ctx, getDefaultZone(meta), domainID, record); err != nil {
+			return fmt.Errorf("error creating %s record %q: %w", e.Type, e.Name, err)
+		}
+	}
+
+	return nil
+}
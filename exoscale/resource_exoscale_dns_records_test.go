@@ -0,0 +1,249 @@
+package exoscale
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	exo "github.com/exoscale/egoscale/v2"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/require"
+
+	providerConfig "github.com/exoscale/terraform-provider-exoscale/pkg/provider/config"
+)
+
+var (
+	testAccResourceDNSRecordsDomainName = acctest.RandomWithPrefix(testPrefix) + ".net"
+
+	testAccResourceDNSRecordsConfigCreate = fmt.Sprintf(`
+resource "exoscale_dns_domain" "exo" {
+  name = "%s"
+}
+
+resource "exoscale_dns_records" "exo" {
+  domain = exoscale_dns_domain.exo.id
+
+  record {
+    name        = ""
+    record_type = "A"
+    content     = "1.2.3.4"
+  }
+
+  record {
+    name        = "www"
+    record_type = "A"
+    content     = "1.2.3.4"
+  }
+
+  record {
+    name        = "www"
+    record_type = "A"
+    content     = "5.6.7.8"
+  }
+}
+`,
+		testAccResourceDNSRecordsDomainName,
+	)
+
+	testAccResourceDNSRecordsConfigUpdate = fmt.Sprintf(`
+resource "exoscale_dns_domain" "exo" {
+  name = "%s"
+}
+
+resource "exoscale_dns_records" "exo" {
+  domain = exoscale_dns_domain.exo.id
+
+  record {
+    name        = ""
+    record_type = "A"
+    content     = "1.2.3.4"
+    ttl         = 60
+  }
+
+  record {
+    name        = "www"
+    record_type = "A"
+    content     = "5.6.7.8"
+  }
+
+  record {
+    name        = ""
+    record_type = "MX"
+    content     = "mta1.%s"
+    prio        = 10
+  }
+}
+`,
+		testAccResourceDNSRecordsDomainName,
+		testAccResourceDNSRecordsDomainName,
+	)
+)
+
+func TestAccResourceDNSRecords(t *testing.T) {
+	r := "exoscale_dns_records.exo"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckResourceDomainDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceDNSRecordsConfigCreate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(r, "record.#", "3"),
+					resource.TestCheckTypeSetElemNestedAttrs(r, "record.*", map[string]string{
+						"name":        "www",
+						"record_type": "A",
+						"content":     "5.6.7.8",
+						"ttl":         "3600",
+					}),
+				),
+			},
+			{
+				Config: testAccResourceDNSRecordsConfigUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(r, "record.#", "3"),
+					resource.TestCheckTypeSetElemNestedAttrs(r, "record.*", map[string]string{
+						"name":        "",
+						"record_type": "A",
+						"content":     "1.2.3.4",
+						"ttl":         "60",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(r, "record.*", map[string]string{
+						"name":        "",
+						"record_type": "MX",
+						"prio":        "10",
+					}),
+				),
+			},
+			{
+				ResourceName:      r,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func Test_dnsRecordsChanges(t *testing.T) {
+	record := func(id, name, rtype, content string, ttl int64) exo.DNSDomainRecord {
+		return exo.DNSDomainRecord{ID: &id, Name: &name, Type: &rtype, Content: &content, TTL: &ttl}
+	}
+
+	existing := []exo.DNSDomainRecord{
+		record("soa", "", "SOA", "ns1.exoscale.ch", 3600),
+		record("ns", "", "NS", "ns1.exoscale.ch", 3600),
+		record("apex", "", "A", "1.2.3.4", 3600),
+		record("www1", "www", "A", "1.2.3.4", 3600),
+		record("www2", "www", "A", "5.6.7.8", 3600),
+		record("www2-dup", "www", "A", "5.6.7.8", 3600),
+		record("other", "other", "CNAME", "example.net", 3600),
+	}
+
+	desired := []dnsRecordsEntry{
+		{Name: "", Type: "A", Content: "1.2.3.4", TTL: 60},
+		{Name: "www", Type: "a", Content: "5.6.7.8", TTL: 3600},
+		{Name: "www", Type: "AAAA", Content: "::1", TTL: 3600},
+	}
+
+	managed := []dnsRecordsEntry{
+		{Name: "", Type: "A", Content: "1.2.3.4", TTL: 3600},
+		{Name: "www", Type: "A", Content: "1.2.3.4", TTL: 3600},
+		{Name: "www", Type: "A", Content: "5.6.7.8", TTL: 3600},
+	}
+
+	ids := func(records []exo.DNSDomainRecord) []string {
+		res := make([]string, len(records))
+		for i, r := range records {
+			res[i] = *r.ID
+		}
+		return res
+	}
+
+	create, update, remove := dnsRecordsChanges(existing, desired, managed, false)
+	require.Equal(t, []dnsRecordsEntry{desired[2]}, create)
+	require.Equal(t, []string{"apex"}, ids(update))
+	require.Equal(t, int64(60), *update[0].TTL)
+	require.Equal(t, []string{"www1", "www2-dup", "other"}, ids(remove))
+
+	// With managed_only, the records never declared are left untouched.
+	_, _, remove = dnsRecordsChanges(existing, desired, managed, true)
+	require.Equal(t, []string{"www1", "www2-dup"}, ids(remove))
+
+	// Deletion only removes the declared records.
+	create, update, remove = dnsRecordsChanges(existing, nil, desired, true)
+	require.Empty(t, create)
+	require.Empty(t, update)
+	require.Equal(t, []string{"apex", "www2", "www2-dup"}, ids(remove))
+}
+
+func Test_dnsRecordsState(t *testing.T) {
+	record := func(name, rtype, content string) exo.DNSDomainRecord {
+		ttl := int64(3600)
+		return exo.DNSDomainRecord{Name: &name, Type: &rtype, Content: &content, TTL: &ttl}
+	}
+
+	existing := []exo.DNSDomainRecord{
+		record("", "SOA", "ns1.exoscale.ch"),
+		record("www", "A", "1.2.3.4"),
+		record("", "A", "1.2.3.4"),
+		record("other", "CNAME", "example.net"),
+	}
+
+	state := dnsRecordsState(existing, nil, false)
+	require.Len(t, state, 3)
+	require.Equal(t, "", state[0].(map[string]interface{})["name"], "expected records sorted by name")
+
+	state = dnsRecordsState(existing, []dnsRecordsEntry{{Name: "www", Type: "A", Content: "1.2.3.4"}}, true)
+	require.Equal(t, []interface{}{map[string]interface{}{
+		"content":     "1.2.3.4",
+		"name":        "www",
+		"prio":        0,
+		"record_type": "A",
+		"ttl":         3600,
+	}}, state)
+}
+
+func Test_resourceDNSRecordsDelete(t *testing.T) {
+	record := func(id, name, rtype, content string) exo.DNSDomainRecord {
+		ttl := int64(3600)
+		return exo.DNSDomainRecord{ID: &id, Name: &name, Type: &rtype, Content: &content, TTL: &ttl}
+	}
+
+	var deleted []string
+	client := &testDNSAPI{
+		listDNSDomainRecords: func(_ context.Context, _, id string) ([]exo.DNSDomainRecord, error) {
+			require.Equal(t, "domain-id", id)
+			return []exo.DNSDomainRecord{
+				record("soa", "", "SOA", "ns1.exoscale.ch"),
+				record("www", "www", "A", "1.2.3.4"),
+				record("other", "other", "A", "1.2.3.4"),
+			}, nil
+		},
+		deleteDNSDomainRecord: func(_ context.Context, _, domainID string, r *exo.DNSDomainRecord) error {
+			require.Equal(t, "domain-id", domainID)
+			deleted = append(deleted, *r.ID)
+			return nil
+		},
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceDNSRecords().Schema, map[string]interface{}{
+		resDNSRecordsAttrDomain: "domain-id",
+		resDNSRecordsAttrRecord: []interface{}{map[string]interface{}{
+			"name":        "www",
+			"record_type": "A",
+			"content":     "1.2.3.4",
+		}},
+	})
+	d.SetId("domain-id")
+
+	diags := resourceDNSRecordsDelete(context.Background(), d, map[string]interface{}{
+		"config":  providerConfig.BaseConfig{},
+		"dns_api": client,
+	})
+	require.False(t, diags.HasError(), "%v", diags)
+	require.Equal(t, []string{"www"}, deleted, "expected only the declared records deleted")
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
+	"strings"
 
 	exo "github.com/exoscale/egoscale/v2"
 	exoapi "github.com/exoscale/egoscale/v2/api"
@@ -29,6 +31,26 @@ func resourceDomain() *schema.Resource {
 				ForceNew:    true,
 				Description: "The DNS domain name.",
 			},
+			"deletion_protection": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				Description: "Prevent the DNS domain (and all its records) from being destroyed. " +
+					"It must be set to `false` in a prior apply before the domain can be deleted.",
+			},
+			"force_destroy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				Description: "Delete all the records of the DNS domain when destroying it. " +
+					"Otherwise, destroying a domain still having records fails.",
+			},
+			"nameservers": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The authoritative nameservers of the DNS domain (i.e. the apex `NS` records), to configure the delegation at the registrar.",
+			},
 			"token": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -57,6 +79,7 @@ func resourceDomain() *schema.Resource {
 
 		CreateContext: resourceDomainCreate,
 		ReadContext:   resourceDomainRead,
+		UpdateContext: resourceDomainUpdate,
 		DeleteContext: resourceDomainDelete,
 		Exists:        resourceDomainExists,
 
@@ -67,6 +90,7 @@ func resourceDomain() *schema.Resource {
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(config.DefaultTimeout),
 			Read:   schema.DefaultTimeout(config.DefaultTimeout),
+			Update: schema.DefaultTimeout(config.DefaultTimeout),
 			Delete: schema.DefaultTimeout(config.DefaultTimeout),
 		},
 
@@ -96,10 +120,10 @@ func resourceDomainStateUpgradeV0(
 	rawState map[string]interface{},
 	meta interface{},
 ) (map[string]interface{}, error) {
-	client := GetComputeClient(meta)
+	client := getDNSAPI(meta)
 
 	name := rawState["id"].(string)
-	domains, err := client.ListDNSDomains(ctx, defaultZone)
+	domains, err := client.ListDNSDomains(ctx, getDefaultZone(meta))
 	if err != nil {
 		return nil, fmt.Errorf("error retrieving domain list: %s", err)
 	}
@@ -120,13 +144,13 @@ func resourceDomainCreate(ctx context.Context, d *schema.ResourceData, meta inte
 	})
 
 	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutRead))
-	ctx = exoapi.WithEndpoint(ctx, exoapi.NewReqEndpoint(getEnvironment(meta), defaultZone))
+	ctx = exoapi.WithEndpoint(ctx, exoapi.NewReqEndpoint(getEnvironment(meta), getDefaultZone(meta)))
 	defer cancel()
 
-	client := GetComputeClient(meta)
+	client := getDNSAPI(meta)
 
 	domainName := d.Get("name").(string)
-	domain, err := client.CreateDNSDomain(ctx, defaultZone, &exo.DNSDomain{UnicodeName: &domainName})
+	domain, err := client.CreateDNSDomain(ctx, getDefaultZone(meta), &exo.DNSDomain{UnicodeName: &domainName})
 	if err != nil {
 		return diag.Errorf("unable to create domain: %s", err)
 	}
@@ -142,17 +166,21 @@ func resourceDomainCreate(ctx context.Context, d *schema.ResourceData, meta inte
 		return diag.Errorf("%s", err)
 	}
 
+	if err := resourceDomainSetNameservers(ctx, d, client, getDefaultZone(meta)); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return nil
 }
 
 func resourceDomainExists(d *schema.ResourceData, meta interface{}) (bool, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), d.Timeout(schema.TimeoutRead))
-	ctx = exoapi.WithEndpoint(ctx, exoapi.NewReqEndpoint(getEnvironment(meta), defaultZone))
+	ctx = exoapi.WithEndpoint(ctx, exoapi.NewReqEndpoint(getEnvironment(meta), getDefaultZone(meta)))
 	defer cancel()
 
-	client := GetComputeClient(meta)
+	client := getDNSAPI(meta)
 
-	_, err := client.GetDNSDomain(ctx, defaultZone, d.Id())
+	_, err := client.GetDNSDomain(ctx, getDefaultZone(meta), d.Id())
 	if err != nil {
 		if errors.Is(err, exoapi.ErrNotFound) {
 			return false, nil
@@ -169,12 +197,12 @@ func resourceDomainRead(ctx context.Context, d *schema.ResourceData, meta interf
 	})
 
 	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutRead))
-	ctx = exoapi.WithEndpoint(ctx, exoapi.NewReqEndpoint(getEnvironment(meta), defaultZone))
+	ctx = exoapi.WithEndpoint(ctx, exoapi.NewReqEndpoint(getEnvironment(meta), getDefaultZone(meta)))
 	defer cancel()
 
-	client := GetComputeClient(meta)
+	client := getDNSAPI(meta)
 
-	domain, err := client.GetDNSDomain(ctx, defaultZone, d.Id())
+	domain, err := client.GetDNSDomain(ctx, getDefaultZone(meta), d.Id())
 	if err != nil {
 		return diag.Errorf("error retrieving domain: %s", err)
 	}
@@ -188,26 +216,82 @@ func resourceDomainRead(ctx context.Context, d *schema.ResourceData, meta interf
 		return diag.Errorf("%s", err)
 	}
 
+	if err := resourceDomainSetNameservers(ctx, d, client, getDefaultZone(meta)); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return nil
 }
 
+func resourceDomainUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tflog.Debug(ctx, "beginning update", map[string]interface{}{
+		"id": resourceDomainIDString(d),
+	})
+
+	// Only client-side attributes (i.e. "deletion_protection") can be updated,
+	// no API call is required.
+
+	tflog.Debug(ctx, "update finished successfully", map[string]interface{}{
+		"id": resourceDomainIDString(d),
+	})
+
+	return resourceDomainRead(ctx, d, meta)
+}
+
 func resourceDomainDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	tflog.Debug(ctx, "beginning delete", map[string]interface{}{
 		"id": resourceDomainIDString(d),
 	})
 
+	if d.Get("deletion_protection").(bool) {
+		return diag.Diagnostics{{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("domain %q is protected against deletion", d.Get("name").(string)),
+			Detail: "To delete this domain, first set \"deletion_protection\" to false " +
+				"and apply the configuration, then destroy the resource.",
+		}}
+	}
+
 	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutRead))
-	ctx = exoapi.WithEndpoint(ctx, exoapi.NewReqEndpoint(getEnvironment(meta), defaultZone))
+	ctx = exoapi.WithEndpoint(ctx, exoapi.NewReqEndpoint(getEnvironment(meta), getDefaultZone(meta)))
 	defer cancel()
 
-	client := GetComputeClient(meta)
+	client := getDNSAPI(meta)
 
-	domain, err := client.GetDNSDomain(ctx, defaultZone, d.Id())
+	domain, err := client.GetDNSDomain(ctx, getDefaultZone(meta), d.Id())
 	if err != nil {
 		return diag.Errorf("error retrieving domain: %s", err)
 	}
 
-	err = client.DeleteDNSDomain(ctx, defaultZone, domain)
+	records, err := resourceDomainUserRecords(ctx, client, getDefaultZone(meta), *domain.ID)
+	if err != nil {
+		return diag.Errorf("error retrieving domain records: %s", err)
+	}
+
+	if len(records) > 0 {
+		if !d.Get("force_destroy").(bool) {
+			names := make([]string, len(records))
+			for i, record := range records {
+				names[i] = fmt.Sprintf("%s %s", defaultString(record.Name, ""), defaultString(record.Type, ""))
+			}
+
+			return diag.Diagnostics{{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("domain %q still has %d record(s)", d.Get("name").(string), len(records)),
+				Detail: fmt.Sprintf("Remaining records: %s.\n\n", strings.Join(names, ", ")) +
+					"To delete this domain along with all its records, set \"force_destroy\" to true " +
+					"and apply the configuration, then destroy the resource.",
+			}}
+		}
+
+		for i := range records {
+			if err := client.DeleteDNSDomainRecord(ctx, getDefaultZone(meta), *domain.ID, &records[i]); err != nil {
+				return diag.Errorf("error deleting domain record: %s", err)
+			}
+		}
+	}
+
+	err = client.DeleteDNSDomain(ctx, getDefaultZone(meta), domain)
 	if err != nil {
 		return diag.Errorf("error deleting domain: %s", err)
 	}
@@ -221,12 +305,12 @@ func resourceDomainDelete(ctx context.Context, d *schema.ResourceData, meta inte
 
 func resourceDomainImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutRead))
-	ctx = exoapi.WithEndpoint(ctx, exoapi.NewReqEndpoint(getEnvironment(meta), defaultZone))
+	ctx = exoapi.WithEndpoint(ctx, exoapi.NewReqEndpoint(getEnvironment(meta), getDefaultZone(meta)))
 	defer cancel()
 
-	client := GetComputeClient(meta)
+	client := getDNSAPI(meta)
 
-	domain, err := client.GetDNSDomain(ctx, defaultZone, d.Id())
+	domain, err := client.GetDNSDomain(ctx, getDefaultZone(meta), d.Id())
 	if err != nil {
 		return nil, err
 	}
@@ -235,9 +319,69 @@ func resourceDomainImport(ctx context.Context, d *schema.ResourceData, meta inte
 		return nil, err
 	}
 
+	if err := d.Set("deletion_protection", false); err != nil {
+		return nil, err
+	}
+
+	if err := d.Set("force_destroy", false); err != nil {
+		return nil, err
+	}
+
 	return []*schema.ResourceData{d}, nil
 }
 
+// resourceDomainUserRecords returns the records of the domain, excluding the ones
+// managed by the platform (SOA record and apex NS records).
+func resourceDomainUserRecords(ctx context.Context, client dnsAPI, zone, domainID string) ([]exo.DNSDomainRecord, error) {
+	records, err := client.ListDNSDomainRecords(ctx, zone, domainID)
+	if err != nil {
+		return nil, err
+	}
+
+	userRecords := make([]exo.DNSDomainRecord, 0, len(records))
+	for _, record := range records {
+		switch recordType := defaultString(record.Type, ""); {
+		case recordType == "SOA":
+			continue
+		case recordType == "NS" && defaultString(record.Name, "") == "":
+			continue
+		}
+
+		userRecords = append(userRecords, record)
+	}
+
+	return userRecords, nil
+}
+
+// dnsDomainNameservers returns the authoritative nameservers of the domain,
+// i.e. the content of its apex NS records (managed by the platform), sorted.
+func dnsDomainNameservers(ctx context.Context, client dnsAPI, zone, domainID string) ([]string, error) {
+	records, err := client.ListDNSDomainRecords(ctx, zone, domainID)
+	if err != nil {
+		return nil, err
+	}
+
+	nameservers := make([]string, 0)
+	for _, record := range records {
+		if defaultString(record.Type, "") == "NS" && defaultString(record.Name, "") == "" {
+			nameservers = append(nameservers, defaultString(record.Content, ""))
+		}
+	}
+	sort.Strings(nameservers)
+
+	return nameservers, nil
+}
+
+// resourceDomainSetNameservers sets the nameservers attribute of the domain d.
+func resourceDomainSetNameservers(ctx context.Context, d *schema.ResourceData, client dnsAPI, zone string) error {
+	nameservers, err := dnsDomainNameservers(ctx, client, zone, d.Id())
+	if err != nil {
+		return fmt.Errorf("error retrieving domain nameservers: %w", err)
+	}
+
+	return d.Set("nameservers", nameservers)
+}
+
 func resourceDomainApply(d *schema.ResourceData, domain *exo.DNSDomain) error {
 	d.SetId(*domain.ID)
 	if err := d.Set("name", domain.UnicodeName); err != nil {
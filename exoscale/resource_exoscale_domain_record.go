@@ -4,11 +4,15 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/http"
+	"strings"
+	"time"
 
 	exo "github.com/exoscale/egoscale/v2"
 	exoapi "github.com/exoscale/egoscale/v2/api"
 	"github.com/exoscale/terraform-provider-exoscale/pkg/config"
 	"github.com/exoscale/terraform-provider-exoscale/pkg/general"
+	"github.com/exoscale/terraform-provider-exoscale/pkg/utils"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -22,6 +26,11 @@ var supportedRecordTypes = []string{
 	"SPF", "SRV", "SSHFP", "TXT", "URL",
 }
 
+// failoverRecordTypes are the record types supporting the health-checked failover mode.
+var failoverRecordTypes = []string{"A", "AAAA", "ALIAS", "CNAME"}
+
+const defaultDomainRecordHealthcheckTimeout = 5
+
 func resourceDomainRecordIDString(d general.ResourceIDStringer) string {
 	return general.ResourceIDString(d, "exoscale_domain_record")
 }
@@ -36,7 +45,7 @@ Corresponding data source: [exoscale_domain_record](../data-sources/domain_recor
 				Type:        schema.TypeString,
 				Required:    true,
 				ForceNew:    true,
-				Description: "The parent [exoscale_domain](./domain.md) to attach the record to.",
+				Description: "The parent [exoscale_dns_domain](./dns_domain.md) to attach the record to.",
 			},
 			"record_type": {
 				Type:         schema.TypeString,
@@ -51,9 +60,16 @@ Corresponding data source: [exoscale_domain_record](../data-sources/domain_recor
 				Description: "The record name, Leave blank (`\"\"`) to create a root record (similar to using `@` in a DNS zone file).",
 			},
 			"content": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "The record value.",
+				Type:             schema.TypeString,
+				Required:         true,
+				DiffSuppressFunc: suppressDomainRecordContentDiff,
+				Description:      "The record value.",
+			},
+			"ignore_content": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				ConflictsWith: []string{"failover"},
+				Description:   "Only use `content` when creating the record, ignoring its changes afterwards (e.g. for records whose value is managed by an external system such as external-dns).",
 			},
 			"ttl": {
 				Type:        schema.TypeInt,
@@ -72,8 +88,43 @@ Corresponding data source: [exoscale_domain_record](../data-sources/domain_recor
 				Computed:    true,
 				Description: "The record *Fully Qualified Domain Name* (FQDN). Useful for aliasing `A`/`AAAA` records with `CNAME`.",
 			},
+			"failover": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"secondary_content": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The record value to use when the healthcheck fails.",
+						},
+						"healthcheck_url": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+							Description:  "The URL to check (`GET`) at apply time: `content` is used if it responds with a `2xx` status, `secondary_content` otherwise.",
+						},
+						"healthcheck_timeout": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      defaultDomainRecordHealthcheckTimeout,
+							ValidateFunc: validation.IntBetween(1, 30),
+							Description:  fmt.Sprintf("The healthcheck timeout (seconds; `1`-`30`; default: `%d`).", defaultDomainRecordHealthcheckTimeout),
+						},
+					},
+				},
+				Description: "Opt-in health-checked failover between `content` and a secondary value, evaluated at apply time only (`A`, `AAAA`, `ALIAS` and `CNAME` records).",
+			},
+			"active_content": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The record value actually served (`content`, or `failover.secondary_content` if the failover healthcheck failed). Known after apply only when `failover` is set, so that every plan re-evaluates the healthcheck.",
+			},
 		},
 
+		CustomizeDiff: resourceDomainRecordCustomizeDiff,
+
 		CreateContext: resourceDomainRecordCreate,
 		ReadContext:   resourceDomainRecordRead,
 		UpdateContext: resourceDomainRecordUpdate,
@@ -81,7 +132,7 @@ Corresponding data source: [exoscale_domain_record](../data-sources/domain_recor
 		Exists:        resourceDomainRecordExists,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceDomainRecordImport,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -129,10 +180,10 @@ func resourceDomainRecordStateUpgradeV0(
 	rawState map[string]interface{},
 	meta interface{},
 ) (map[string]interface{}, error) {
-	client := GetComputeClient(meta)
+	client := getDNSAPI(meta)
 
 	domainName := rawState["domain"].(string)
-	domains, err := client.ListDNSDomains(ctx, defaultZone)
+	domains, err := client.ListDNSDomains(ctx, getDefaultZone(meta))
 	if err != nil {
 		return nil, fmt.Errorf("error retrieving domain list: %s", err)
 	}
@@ -144,7 +195,7 @@ func resourceDomainRecordStateUpgradeV0(
 		}
 	}
 
-	records, err := client.ListDNSDomainRecords(ctx, defaultZone, rawState["domain"].(string))
+	records, err := client.ListDNSDomainRecords(ctx, getDefaultZone(meta), rawState["domain"].(string))
 	if err != nil {
 		return nil, fmt.Errorf("error retrieving domain records: %q", err)
 	}
@@ -161,19 +212,96 @@ func resourceDomainRecordStateUpgradeV0(
 	return rawState, nil
 }
 
+// resourceDomainRecordImport accepts either a record ID or a "<DOMAIN>/<NAME>/<TYPE>"
+// identifier (NAME being empty for root records), resolved to the matching record ID.
+func resourceDomainRecordImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if !strings.Contains(d.Id(), "/") {
+		return []*schema.ResourceData{d}, nil
+	}
+
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf(`invalid ID %q, expected format "<ID>" or "<DOMAIN>/<NAME>/<TYPE>"`, d.Id())
+	}
+	domainName, name, rtype := parts[0], parts[1], parts[2]
+
+	ctx = exoapi.WithEndpoint(ctx, exoapi.NewReqEndpoint(getEnvironment(meta), getDefaultZone(meta)))
+
+	client := getDNSAPI(meta)
+
+	domains, err := client.ListDNSDomains(ctx, getDefaultZone(meta))
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving domains: %w", err)
+	}
+
+	var domain *exo.DNSDomain
+	for i := range domains {
+		if *domains[i].UnicodeName == domainName || *domains[i].ID == domainName {
+			domain = &domains[i]
+			break
+		}
+	}
+	if domain == nil {
+		return nil, fmt.Errorf("domain %q not found", domainName)
+	}
+
+	records, err := client.ListDNSDomainRecords(ctx, getDefaultZone(meta), *domain.ID)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving domain records: %w", err)
+	}
+
+	matches := domainRecordsMatching(records, name, rtype)
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("no %s record named %q found in domain %q", rtype, name, domainName)
+	case 1:
+	default:
+		ids := make([]string, len(matches))
+		for i, record := range matches {
+			ids[i] = fmt.Sprintf("%s (%s)", *record.ID, *record.Content)
+		}
+		return nil, fmt.Errorf(
+			"%d %s records named %q found in domain %q, please import by ID instead: %s",
+			len(matches), rtype, name, domainName, strings.Join(ids, ", "),
+		)
+	}
+
+	d.SetId(*matches[0].ID)
+	if err := d.Set("domain", *domain.ID); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// domainRecordsMatching returns the records having the specified name and type (case-insensitive).
+func domainRecordsMatching(records []exo.DNSDomainRecord, name, rtype string) []exo.DNSDomainRecord {
+	matches := make([]exo.DNSDomainRecord, 0)
+	for _, record := range records {
+		if record.Name == nil || record.Type == nil {
+			continue
+		}
+		if *record.Name == name && strings.EqualFold(*record.Type, rtype) {
+			matches = append(matches, record)
+		}
+	}
+
+	return matches
+}
+
 func resourceDomainRecordCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	tflog.Debug(ctx, "beginning create", map[string]interface{}{
 		"id": resourceDomainRecordIDString(d),
 	})
 
 	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutRead))
-	ctx = exoapi.WithEndpoint(ctx, exoapi.NewReqEndpoint(getEnvironment(meta), defaultZone))
+	ctx = exoapi.WithEndpoint(ctx, exoapi.NewReqEndpoint(getEnvironment(meta), getDefaultZone(meta)))
 	defer cancel()
 
-	client := GetComputeClient(meta)
+	client := getDNSAPI(meta)
 
 	name := d.Get("name").(string)
-	content := d.Get("content").(string)
+	content := resourceDomainRecordActiveContent(ctx, d)
 	rtype := d.Get("record_type").(string)
 	var ttl *int64
 	if t := int64(d.Get("ttl").(int)); t > 0 {
@@ -183,7 +311,7 @@ func resourceDomainRecordCreate(ctx context.Context, d *schema.ResourceData, met
 	if t := int64(d.Get("prio").(int)); t > 0 {
 		prio = &t
 	}
-	record, err := client.CreateDNSDomainRecord(ctx, defaultZone, d.Get("domain").(string), &exo.DNSDomainRecord{
+	record, err := client.CreateDNSDomainRecord(ctx, getDefaultZone(meta), d.Get("domain").(string), &exo.DNSDomainRecord{
 		Name:     &name,
 		Content:  &content,
 		Type:     &rtype,
@@ -205,15 +333,15 @@ func resourceDomainRecordCreate(ctx context.Context, d *schema.ResourceData, met
 
 func resourceDomainRecordExists(d *schema.ResourceData, meta interface{}) (bool, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), d.Timeout(schema.TimeoutRead))
-	ctx = exoapi.WithEndpoint(ctx, exoapi.NewReqEndpoint(getEnvironment(meta), defaultZone))
+	ctx = exoapi.WithEndpoint(ctx, exoapi.NewReqEndpoint(getEnvironment(meta), getDefaultZone(meta)))
 	defer cancel()
 
-	client := GetComputeClient(meta)
+	client := getDNSAPI(meta)
 
 	domainID := d.Get("domain").(string)
 
 	if domainID != "" {
-		_, err := client.GetDNSDomainRecord(ctx, defaultZone, domainID, d.Id())
+		_, err := client.GetDNSDomainRecord(ctx, getDefaultZone(meta), domainID, d.Id())
 		if err != nil {
 			if errors.Is(err, exoapi.ErrNotFound) {
 				return false, nil
@@ -230,13 +358,13 @@ func resourceDomainRecordExists(d *schema.ResourceData, meta interface{}) (bool,
 		"id": resourceDomainIDString(d),
 	})
 
-	domains, err := client.ListDNSDomains(ctx, defaultZone)
+	domains, err := client.ListDNSDomains(ctx, getDefaultZone(meta))
 	if err != nil {
 		return false, err
 	}
 
 	for _, domain := range domains {
-		records, err := client.ListDNSDomainRecords(ctx, defaultZone, *domain.ID)
+		records, err := client.ListDNSDomainRecords(ctx, getDefaultZone(meta), *domain.ID)
 		if err != nil {
 			return false, err
 		}
@@ -261,20 +389,20 @@ func resourceDomainRecordRead(ctx context.Context, d *schema.ResourceData, meta
 	})
 
 	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutRead))
-	ctx = exoapi.WithEndpoint(ctx, exoapi.NewReqEndpoint(getEnvironment(meta), defaultZone))
+	ctx = exoapi.WithEndpoint(ctx, exoapi.NewReqEndpoint(getEnvironment(meta), getDefaultZone(meta)))
 	defer cancel()
 
-	client := GetComputeClient(meta)
+	client := getDNSAPI(meta)
 
 	domainID := d.Get("domain").(string)
 
 	if domainID != "" {
-		domain, err := client.GetDNSDomain(ctx, defaultZone, domainID)
+		domain, err := client.GetDNSDomain(ctx, getDefaultZone(meta), domainID)
 		if err != nil {
 			return diag.Errorf("error retrieving domain: %s", err)
 		}
 
-		record, err := client.GetDNSDomainRecord(ctx, defaultZone, domainID, d.Id())
+		record, err := client.GetDNSDomainRecord(ctx, getDefaultZone(meta), domainID, d.Id())
 		if err != nil {
 			return diag.Errorf("error retrieving domain record: %s", err)
 		}
@@ -297,13 +425,13 @@ func resourceDomainRecordRead(ctx context.Context, d *schema.ResourceData, meta
 		"id": resourceDomainIDString(d),
 	})
 
-	domains, err := client.ListDNSDomains(ctx, defaultZone)
+	domains, err := client.ListDNSDomains(ctx, getDefaultZone(meta))
 	if err != nil {
 		return diag.Errorf("error retrieving domains: %s", err)
 	}
 
 	for _, domain := range domains {
-		records, err := client.ListDNSDomainRecords(ctx, defaultZone, *domain.ID)
+		records, err := client.ListDNSDomainRecords(ctx, getDefaultZone(meta), *domain.ID)
 		if err != nil {
 			return diag.Errorf("error retrieving domain records: %s", err)
 		}
@@ -337,13 +465,13 @@ func resourceDomainRecordUpdate(ctx context.Context, d *schema.ResourceData, met
 	})
 
 	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutRead))
-	ctx = exoapi.WithEndpoint(ctx, exoapi.NewReqEndpoint(getEnvironment(meta), defaultZone))
+	ctx = exoapi.WithEndpoint(ctx, exoapi.NewReqEndpoint(getEnvironment(meta), getDefaultZone(meta)))
 	defer cancel()
 
-	client := GetComputeClient(meta)
+	client := getDNSAPI(meta)
 
 	name := d.Get("name").(string)
-	content := d.Get("content").(string)
+	content := resourceDomainRecordActiveContent(ctx, d)
 	rtype := d.Get("record_type").(string)
 	var ttl *int64
 	if t := int64(d.Get("ttl").(int)); t > 0 {
@@ -354,7 +482,7 @@ func resourceDomainRecordUpdate(ctx context.Context, d *schema.ResourceData, met
 		prio = &t
 	}
 	id := d.Id()
-	err := client.UpdateDNSDomainRecord(ctx, defaultZone, d.Get("domain").(string), &exo.DNSDomainRecord{
+	err := client.UpdateDNSDomainRecord(ctx, getDefaultZone(meta), d.Get("domain").(string), &exo.DNSDomainRecord{
 		ID:       &id,
 		Name:     &name,
 		Content:  &content,
@@ -372,12 +500,12 @@ func resourceDomainRecordUpdate(ctx context.Context, d *schema.ResourceData, met
 
 	domainID := d.Get("domain").(string)
 
-	domain, err := client.GetDNSDomain(ctx, defaultZone, domainID)
+	domain, err := client.GetDNSDomain(ctx, getDefaultZone(meta), domainID)
 	if err != nil {
 		return diag.Errorf("error retrieving domain: %s", err)
 	}
 
-	record, err := client.GetDNSDomainRecord(ctx, defaultZone, domainID, d.Id())
+	record, err := client.GetDNSDomainRecord(ctx, getDefaultZone(meta), domainID, d.Id())
 	if err != nil {
 		return diag.Errorf("error retrieving domain record: %s", err)
 	}
@@ -396,17 +524,17 @@ func resourceDomainRecordDelete(ctx context.Context, d *schema.ResourceData, met
 	})
 
 	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutRead))
-	ctx = exoapi.WithEndpoint(ctx, exoapi.NewReqEndpoint(getEnvironment(meta), defaultZone))
+	ctx = exoapi.WithEndpoint(ctx, exoapi.NewReqEndpoint(getEnvironment(meta), getDefaultZone(meta)))
 	defer cancel()
 
-	client := GetComputeClient(meta)
+	client := getDNSAPI(meta)
 
-	record, err := client.GetDNSDomainRecord(ctx, defaultZone, d.Get("domain").(string), d.Id())
+	record, err := client.GetDNSDomainRecord(ctx, getDefaultZone(meta), d.Get("domain").(string), d.Id())
 	if err != nil {
 		return diag.Errorf("error retrieving domain record: %s", err)
 	}
 
-	err = client.DeleteDNSDomainRecord(ctx, defaultZone, d.Get("domain").(string), record)
+	err = client.DeleteDNSDomainRecord(ctx, getDefaultZone(meta), d.Get("domain").(string), record)
 	if err != nil {
 		return diag.Errorf("error deleting domain record: %s", err)
 	}
@@ -423,7 +551,15 @@ func resourceDomainRecordApply(d *schema.ResourceData, domainName string, record
 	if err := d.Set("name", record.Name); err != nil {
 		return err
 	}
-	if err := d.Set("content", record.Content); err != nil {
+	// With failover enabled, the record serving the secondary value
+	// must not show up as a change of the (primary) content.
+	if secondary, ok := d.GetOk("failover.0.secondary_content"); !ok ||
+		record.Content == nil || *record.Content != secondary.(string) {
+		if err := d.Set("content", record.Content); err != nil {
+			return err
+		}
+	}
+	if err := d.Set("active_content", record.Content); err != nil {
 		return err
 	}
 	if err := d.Set("record_type", record.Type); err != nil {
@@ -447,3 +583,72 @@ func resourceDomainRecordApply(d *schema.ResourceData, domainName string, record
 
 	return nil
 }
+
+// suppressDomainRecordContentDiff ignores the content changes of existing records
+// whose value is managed outside of Terraform (ignore_content).
+func suppressDomainRecordContentDiff(_, _, _ string, d *schema.ResourceData) bool {
+	return d.Id() != "" && d.Get("ignore_content").(bool)
+}
+
+// resourceDomainRecordCustomizeDiff defers the evaluation of the failover healthcheck (if any)
+// to the apply: active_content is unknown in every plan of a record with failover enabled.
+func resourceDomainRecordCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if _, ok := d.GetOk("failover"); !ok {
+		return nil
+	}
+
+	if d.NewValueKnown("record_type") &&
+		!utils.In(failoverRecordTypes, strings.ToUpper(d.Get("record_type").(string))) {
+		return fmt.Errorf("failover is only supported for %s records", strings.Join(failoverRecordTypes, ", "))
+	}
+
+	return d.SetNewComputed("active_content")
+}
+
+// resourceDomainRecordActiveContent returns the record value to serve:
+// content, unless the failover healthcheck (if any) fails.
+func resourceDomainRecordActiveContent(ctx context.Context, d *schema.ResourceData) string {
+	content := d.Get("content").(string)
+
+	if _, ok := d.GetOk("failover"); !ok {
+		return content
+	}
+
+	url := d.Get("failover.0.healthcheck_url").(string)
+	timeout := time.Duration(d.Get("failover.0.healthcheck_timeout").(int)) * time.Second
+
+	if err := domainRecordHealthcheck(ctx, url, timeout); err != nil {
+		tflog.Warn(ctx, "domain record failover healthcheck failed, using secondary content", map[string]interface{}{
+			"id":    resourceDomainRecordIDString(d),
+			"url":   url,
+			"error": err.Error(),
+		})
+		return d.Get("failover.0.secondary_content").(string)
+	}
+
+	return content
+}
+
+// domainRecordHealthcheck performs a single GET request to the specified URL,
+// returning an error unless it responds with a 2xx status within the timeout.
+func domainRecordHealthcheck(ctx context.Context, url string, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("unexpected response status %s", resp.Status)
+	}
+
+	return nil
+}
@@ -4,14 +4,20 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	exo "github.com/exoscale/egoscale/v2"
 	exoapi "github.com/exoscale/egoscale/v2/api"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/require"
 )
 
 var (
@@ -143,10 +149,168 @@ func TestAccResourceDomainRecord(t *testing.T) {
 						s[0].Attributes)
 				},
 			},
+			{
+				ResourceName:      "exoscale_domain_record.a",
+				ImportStateId:     testAccResourceDomainRecordDomainName + "//A",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "exoscale_domain_record.mx",
+				ImportStateId:     fmt.Sprintf("%s/%s/mx", testAccResourceDomainRecordDomainName, testAccResourceDomainRecordNameUpdated),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func Test_domainRecordsMatching(t *testing.T) {
+	record := func(id, name, rtype string) exo.DNSDomainRecord {
+		return exo.DNSDomainRecord{ID: &id, Name: &name, Type: &rtype}
+	}
+
+	records := []exo.DNSDomainRecord{
+		record("1", "", "A"),
+		record("2", "www", "A"),
+		record("3", "www", "A"),
+		record("4", "www", "AAAA"),
+		{}, // records lacking a name or type are ignored
+	}
+
+	tests := []struct {
+		name, rtype string
+		want        []string
+	}{
+		{name: "", rtype: "A", want: []string{"1"}},
+		{name: "www", rtype: "a", want: []string{"2", "3"}},
+		{name: "www", rtype: "AAAA", want: []string{"4"}},
+		{name: "www", rtype: "MX", want: []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name+"/"+tt.rtype, func(t *testing.T) {
+			got := make([]string, 0)
+			for _, r := range domainRecordsMatching(records, tt.name, tt.rtype) {
+				got = append(got, *r.ID)
+			}
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestAccResourceDomainRecord_Failover(t *testing.T) {
+	var healthy atomic.Bool
+	healthy.Store(true)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		if !healthy.Load() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer server.Close()
+
+	r := "exoscale_domain_record.failover"
+	config := fmt.Sprintf(`
+resource "exoscale_domain" "exo" {
+  name = "%s"
+}
+
+resource "exoscale_domain_record" "failover" {
+  domain      = exoscale_domain.exo.id
+  name        = "www"
+  record_type = "A"
+  content     = "1.2.3.4"
+
+  failover {
+    secondary_content = "5.6.7.8"
+    healthcheck_url   = "%s"
+  }
+}
+`,
+		acctest.RandomWithPrefix(testPrefix)+".net",
+		server.URL,
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckResourceDomainRecordDestroy,
+		Steps: []resource.TestStep{
+			{
+				// Healthy: the primary content is served
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(r, "content", "1.2.3.4"),
+					resource.TestCheckResourceAttr(r, "active_content", "1.2.3.4"),
+				),
+				// The healthcheck is re-evaluated at every apply.
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				// Unhealthy: failover to the secondary content, without changing the configured content
+				PreConfig: func() { healthy.Store(false) },
+				Config:    config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(r, "content", "1.2.3.4"),
+					resource.TestCheckResourceAttr(r, "active_content", "5.6.7.8"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				// Healthy again: back to the primary content
+				PreConfig:          func() { healthy.Store(true) },
+				Config:             config,
+				Check:              resource.TestCheckResourceAttr(r, "active_content", "1.2.3.4"),
+				ExpectNonEmptyPlan: true,
+			},
 		},
 	})
 }
 
+func Test_suppressDomainRecordContentDiff(t *testing.T) {
+	tests := []struct {
+		name          string
+		id            string
+		ignoreContent bool
+		want          bool
+	}{
+		{name: "existing record", id: "1"},
+		{name: "new record ignoring content", ignoreContent: true},
+		{name: "existing record ignoring content", id: "1", ignoreContent: true, want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, resourceDomainRecord().Schema, map[string]interface{}{
+				"ignore_content": tt.ignoreContent,
+			})
+			d.SetId(tt.id)
+
+			require.Equal(t, tt.want, suppressDomainRecordContentDiff("content", "1.2.3.4", "5.6.7.8", d))
+		})
+	}
+}
+
+func Test_domainRecordHealthcheck(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/ok":
+		case "/slow":
+			time.Sleep(200 * time.Millisecond)
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+
+	require.NoError(t, domainRecordHealthcheck(ctx, server.URL+"/ok", time.Second))
+	require.Error(t, domainRecordHealthcheck(ctx, server.URL+"/ko", time.Second))
+	require.Error(t, domainRecordHealthcheck(ctx, server.URL+"/slow", 50*time.Millisecond))
+}
+
 func testAccCheckResourceDomainRecordExists(n string, domain *exo.DNSDomain, record *exo.DNSDomainRecord) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
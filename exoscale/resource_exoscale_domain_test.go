@@ -4,11 +4,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"regexp"
 	"testing"
 
 	exo "github.com/exoscale/egoscale/v2"
 	exoapi "github.com/exoscale/egoscale/v2/api"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -21,6 +23,15 @@ var (
 resource "exoscale_domain" "exo" {
   name = "%s"
 }
+`,
+		testAccResourceDomainName,
+	)
+
+	testAccDNSDomainProtected = fmt.Sprintf(`
+resource "exoscale_domain" "exo" {
+  name                = "%s"
+  deletion_protection = true
+}
 `,
 		testAccResourceDomainName,
 	)
@@ -39,11 +50,34 @@ func TestAccResourceDomain(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckResourceDomainExists("exoscale_domain.exo", &domain),
 					testAccCheckResourceDomainAttributes(testAttrs{
-						"name": validateString(testAccResourceDomainName),
+						"name":          validateString(testAccResourceDomainName),
+						"nameservers.0": validation.ToDiagFunc(validation.NoZeroValues),
 					}),
 					testAccCheckResourceDomainStateUpgradeV1("exoscale_domain.exo"),
 				),
 			},
+			{
+				Config: testAccDNSDomainProtected,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceDomainAttributes(testAttrs{
+						"name":                validateString(testAccResourceDomainName),
+						"deletion_protection": validateString("true"),
+					}),
+				),
+			},
+			{
+				Config:      testAccDNSDomainProtected,
+				Destroy:     true,
+				ExpectError: regexp.MustCompile("is protected against deletion"),
+			},
+			{
+				Config: testAccDNSDomainCreate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceDomainAttributes(testAttrs{
+						"deletion_protection": validateString("false"),
+					}),
+				),
+			},
 			{
 				ResourceName:      "exoscale_domain.exo",
 				ImportState:       true,
@@ -60,6 +94,66 @@ func TestAccResourceDomain(t *testing.T) {
 	})
 }
 
+func TestAccResourceDomainForceDestroy(t *testing.T) {
+	var (
+		domain     = exo.DNSDomain{}
+		domainName = acctest.RandomWithPrefix(testPrefix) + ".net"
+		config     = func(forceDestroy bool) string {
+			return fmt.Sprintf(`
+resource "exoscale_domain" "exo" {
+  name          = "%s"
+  force_destroy = %t
+}
+`,
+				domainName,
+				forceDestroy,
+			)
+		}
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckResourceDomainDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config(false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceDomainExists("exoscale_domain.exo", &domain),
+					func(s *terraform.State) error {
+						// Create a record outside of Terraform
+						client := GetComputeClient(testAccProvider.Meta())
+						ctx := exoapi.WithEndpoint(
+							context.Background(),
+							exoapi.NewReqEndpoint(testEnvironment, defaultZone),
+						)
+
+						_, err := client.CreateDNSDomainRecord(ctx, defaultZone, *domain.ID, &exo.DNSDomainRecord{
+							Name:    nonEmptyStringPtr("www"),
+							Type:    nonEmptyStringPtr("A"),
+							Content: nonEmptyStringPtr("1.2.3.4"),
+						})
+						return err
+					},
+				),
+			},
+			{
+				Config:      config(false),
+				Destroy:     true,
+				ExpectError: regexp.MustCompile("still has 1 record"),
+			},
+			{
+				Config: config(true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceDomainAttributes(testAttrs{
+						"force_destroy": validateString("true"),
+					}),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckResourceDomainExists(n string, domain *exo.DNSDomain) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
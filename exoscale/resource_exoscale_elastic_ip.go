@@ -15,8 +15,10 @@ import (
 
 	egoscale "github.com/exoscale/egoscale/v2"
 	exoapi "github.com/exoscale/egoscale/v2/api"
+	"github.com/exoscale/egoscale/v2/oapi"
 	"github.com/exoscale/terraform-provider-exoscale/pkg/config"
 	"github.com/exoscale/terraform-provider-exoscale/pkg/general"
+	"github.com/exoscale/terraform-provider-exoscale/pkg/utils"
 )
 
 const (
@@ -38,6 +40,10 @@ const (
 	resElasticIPAttrZone                     = "zone"
 )
 
+// elasticIPHealthcheckPollInterval is the interval at which the Elastic IP is polled
+// while waiting for a healthcheck change to be active.
+var elasticIPHealthcheckPollInterval = oapi.DefaultPollingInterval
+
 func resourceElasticIPIDString(d general.ResourceIDStringer) string {
 	return general.ResourceIDString(d, "exoscale_elastic_ip")
 }
@@ -130,7 +136,7 @@ func resourceElasticIP() *schema.Resource {
 						},
 					},
 				},
-				Description: "Healthcheck configuration for *managed* EIPs. It can not be added to an existing *Unmanaged* EIP.",
+				Description: "Healthcheck configuration for *managed* EIPs. It can not be added to an existing *Unmanaged* EIP. Creating or updating it waits for the healthcheck to be active, including its initial probing cycle (`strikes_ok` × `interval`).",
 			},
 			resElasticIPAttrIPAddress: {
 				Type:        schema.TypeString,
@@ -149,10 +155,11 @@ func resourceElasticIP() *schema.Resource {
 				Description: "A map of key/value labels.",
 			},
 			resElasticIPAttrZone: {
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
-				Description: "The Exoscale [Zone](https://www.exoscale.com/datacenters/) name.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				Description:      "The Exoscale [Zone](https://www.exoscale.com/datacenters/) name.",
+				ValidateDiagFunc: utils.ValidateZone(),
 			},
 		},
 
@@ -161,6 +168,8 @@ func resourceElasticIP() *schema.Resource {
 		UpdateContext: resourceElasticIPUpdate,
 		DeleteContext: resourceElasticIPDelete,
 
+		CustomizeDiff: utils.QuotaCustomizeDiff("elastic-ip"),
+
 		Importer: &schema.ResourceImporter{
 			StateContext: zonedStateContextFunc,
 		},
@@ -261,6 +270,12 @@ func resourceElasticIPCreate(ctx context.Context, d *schema.ResourceData, meta i
 
 	d.SetId(*elasticIP.ID)
 
+	if elasticIP.Healthcheck != nil {
+		if err := resourceElasticIPWaitForHealthcheck(ctx, client.Client, zone, *elasticIP.ID, elasticIP.Healthcheck); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	if v, ok := d.GetOk(resElasticIPAttrReverseDNS); ok {
 		rdns := v.(string)
 		err := client.UpdateElasticIPReverseDNS(
@@ -318,7 +333,7 @@ func resourceElasticIPUpdate(ctx context.Context, d *schema.ResourceData, meta i
 
 	zone := d.Get(resElasticIPAttrZone).(string)
 
-	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutDelete))
+	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutUpdate))
 	ctx = exoapi.WithEndpoint(ctx, exoapi.NewReqEndpoint(getEnvironment(meta), zone))
 	defer cancel()
 
@@ -404,6 +419,12 @@ func resourceElasticIPUpdate(ctx context.Context, d *schema.ResourceData, meta i
 		if err = client.UpdateElasticIP(ctx, zone, elasticIP); err != nil {
 			return diag.FromErr(err)
 		}
+
+		if d.HasChange("healthcheck") && elasticIP.Healthcheck != nil {
+			if err := resourceElasticIPWaitForHealthcheck(ctx, client.Client, zone, *elasticIP.ID, elasticIP.Healthcheck); err != nil {
+				return diag.FromErr(err)
+			}
+		}
 	}
 
 	if d.HasChange(resElasticIPAttrReverseDNS) {
@@ -523,3 +544,71 @@ func resourceElasticIPApply(
 
 // resElasticIPAttrHealthcheck returns an elastic_ip resource attribute key formatted for a "healthcheck {}" block.
 func resElasticIPAttrHealthcheck(a string) string { return fmt.Sprintf("healthcheck.0.%s", a) }
+
+// resourceElasticIPWaitForHealthcheck waits for the expected healthcheck to be
+// reported by the API, then for the platform to complete its initial probing
+// cycle (strikes_ok × interval), or for the context to be done.
+func resourceElasticIPWaitForHealthcheck(
+	ctx context.Context,
+	client *egoscale.Client,
+	zone string,
+	id string,
+	expected *egoscale.ElasticIPHealthcheck,
+) error {
+	res, err := oapi.NewPoller().
+		WithInterval(elasticIPHealthcheckPollInterval).
+		Poll(ctx, func(ctx context.Context) (bool, interface{}, error) {
+			elasticIP, err := client.GetElasticIP(ctx, zone, id)
+			if err != nil {
+				return false, nil, err
+			}
+
+			if !elasticIPHealthcheckApplied(elasticIP.Healthcheck, expected) {
+				return false, nil, nil
+			}
+
+			return true, elasticIP.Healthcheck, nil
+		})
+	if err != nil {
+		return fmt.Errorf("error waiting for Elastic IP healthcheck to be active: %w", err)
+	}
+
+	healthcheck := res.(*egoscale.ElasticIPHealthcheck)
+	if healthcheck.Interval == nil || healthcheck.StrikesOK == nil {
+		return nil
+	}
+
+	timer := time.NewTimer(*healthcheck.Interval * time.Duration(*healthcheck.StrikesOK))
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("error waiting for Elastic IP healthcheck to be active: %w", ctx.Err())
+	}
+}
+
+// elasticIPHealthcheckApplied reports whether the actual healthcheck matches the
+// expected one, unset optional settings of the latter being left to the API defaults.
+func elasticIPHealthcheckApplied(actual, expected *egoscale.ElasticIPHealthcheck) bool {
+	if actual == nil || expected == nil {
+		return actual == expected
+	}
+
+	if defaultString(actual.Mode, "") != defaultString(expected.Mode, "") ||
+		actual.Port == nil || expected.Port == nil || *actual.Port != *expected.Port ||
+		defaultString(actual.URI, "") != defaultString(expected.URI, "") {
+		return false
+	}
+
+	durationMatches := func(a, e *time.Duration) bool { return e == nil || (a != nil && *a == *e) }
+	int64Matches := func(a, e *int64) bool { return e == nil || (a != nil && *a == *e) }
+
+	return durationMatches(actual.Interval, expected.Interval) &&
+		durationMatches(actual.Timeout, expected.Timeout) &&
+		int64Matches(actual.StrikesFail, expected.StrikesFail) &&
+		int64Matches(actual.StrikesOK, expected.StrikesOK) &&
+		(expected.TLSSNI == nil || defaultString(actual.TLSSNI, "") == *expected.TLSSNI) &&
+		(expected.TLSSkipVerify == nil || defaultBool(actual.TLSSkipVerify, false) == *expected.TLSSkipVerify)
+}
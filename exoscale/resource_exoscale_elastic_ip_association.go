@@ -0,0 +1,341 @@
+package exoscale
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	egoscale "github.com/exoscale/egoscale/v2"
+	exoapi "github.com/exoscale/egoscale/v2/api"
+	"github.com/exoscale/terraform-provider-exoscale/pkg/config"
+	"github.com/exoscale/terraform-provider-exoscale/pkg/general"
+	"github.com/exoscale/terraform-provider-exoscale/pkg/utils"
+)
+
+const (
+	resElasticIPAssociationAttrElasticIPID = "elastic_ip_id"
+	resElasticIPAssociationAttrInstanceIDs = "instance_ids"
+	resElasticIPAssociationAttrZone        = "zone"
+)
+
+func resourceElasticIPAssociationIDString(d general.ResourceIDStringer) string {
+	return general.ResourceIDString(d, "exoscale_elastic_ip_association")
+}
+
+func resourceElasticIPAssociation() *schema.Resource {
+	s := map[string]*schema.Schema{
+		resElasticIPAssociationAttrElasticIPID: {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IsUUID,
+			Description:  "The [exoscale_elastic_ip](./elastic_ip.md) (ID) to associate.",
+		},
+		resElasticIPAssociationAttrInstanceIDs: {
+			Type:        schema.TypeSet,
+			Required:    true,
+			MinItems:    1,
+			Set:         schema.HashString,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "A list of [exoscale_compute_instance](./compute_instance.md) (IDs) to associate the Elastic IP to.",
+		},
+		resElasticIPAssociationAttrZone: {
+			Type:             schema.TypeString,
+			Required:         true,
+			ForceNew:         true,
+			ValidateDiagFunc: utils.ValidateZone(),
+			Description:      "The Exoscale [Zone](https://www.exoscale.com/datacenters/) name.",
+		},
+	}
+
+	return &schema.Resource{
+		Schema: s,
+
+		CreateContext: resourceElasticIPAssociationCreate,
+		ReadContext:   resourceElasticIPAssociationRead,
+		UpdateContext: resourceElasticIPAssociationUpdate,
+		DeleteContext: resourceElasticIPAssociationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceElasticIPAssociationImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(config.DefaultTimeout),
+			Read:   schema.DefaultTimeout(config.DefaultTimeout),
+			Update: schema.DefaultTimeout(config.DefaultTimeout),
+			Delete: schema.DefaultTimeout(config.DefaultTimeout),
+		},
+	}
+}
+
+func resourceElasticIPAssociationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tflog.Debug(ctx, "beginning create", map[string]interface{}{
+		"id": resourceElasticIPAssociationIDString(d),
+	})
+
+	zone := d.Get(resElasticIPAssociationAttrZone).(string)
+
+	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutCreate))
+	ctx = exoapi.WithEndpoint(ctx, exoapi.NewReqEndpoint(getEnvironment(meta), zone))
+	defer cancel()
+
+	client := GetComputeClient(meta)
+
+	elasticIP, err := client.GetElasticIP(ctx, zone, d.Get(resElasticIPAssociationAttrElasticIPID).(string))
+	if err != nil {
+		return diag.Errorf("unable to retrieve Elastic IP: %v", err)
+	}
+
+	instanceIDs := utils.SchemaSetToStringArray(d.Get(resElasticIPAssociationAttrInstanceIDs).(*schema.Set))
+	for _, id := range instanceIDs {
+		if err := resourceElasticIPAssociationAttach(ctx, client.Client, zone, elasticIP, id); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId(*elasticIP.ID)
+
+	tflog.Debug(ctx, "create finished successfully", map[string]interface{}{
+		"id": resourceElasticIPAssociationIDString(d),
+	})
+
+	return resourceElasticIPAssociationRead(ctx, d, meta)
+}
+
+func resourceElasticIPAssociationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tflog.Debug(ctx, "beginning read", map[string]interface{}{
+		"id": resourceElasticIPAssociationIDString(d),
+	})
+
+	zone := d.Get(resElasticIPAssociationAttrZone).(string)
+
+	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutRead))
+	ctx = exoapi.WithEndpoint(ctx, exoapi.NewReqEndpoint(getEnvironment(meta), zone))
+	defer cancel()
+
+	client := GetComputeClient(meta)
+
+	if _, err := client.GetElasticIP(ctx, zone, d.Id()); err != nil {
+		if errors.Is(err, exoapi.ErrNotFound) {
+			// Resource doesn't exist anymore, signaling the core to remove it from the state.
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	// Instances replaced or detached out-of-band are dropped from the state,
+	// so that the association is restored on the next apply.
+	instanceIDs, err := resourceElasticIPAssociationAttachedInstances(
+		ctx,
+		client.Client,
+		zone,
+		d.Id(),
+		utils.SchemaSetToStringArray(d.Get(resElasticIPAssociationAttrInstanceIDs).(*schema.Set)),
+	)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(resElasticIPAssociationAttrElasticIPID, d.Id()); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(resElasticIPAssociationAttrInstanceIDs, instanceIDs); err != nil {
+		return diag.FromErr(err)
+	}
+
+	tflog.Debug(ctx, "read finished successfully", map[string]interface{}{
+		"id": resourceElasticIPAssociationIDString(d),
+	})
+
+	return nil
+}
+
+func resourceElasticIPAssociationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tflog.Debug(ctx, "beginning update", map[string]interface{}{
+		"id": resourceElasticIPAssociationIDString(d),
+	})
+
+	zone := d.Get(resElasticIPAssociationAttrZone).(string)
+
+	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutUpdate))
+	ctx = exoapi.WithEndpoint(ctx, exoapi.NewReqEndpoint(getEnvironment(meta), zone))
+	defer cancel()
+
+	client := GetComputeClient(meta)
+
+	if d.HasChange(resElasticIPAssociationAttrInstanceIDs) {
+		elasticIP := &egoscale.ElasticIP{ID: utils.NonEmptyStringPtr(d.Id())}
+
+		o, n := d.GetChange(resElasticIPAssociationAttrInstanceIDs)
+		old, cur := o.(*schema.Set), n.(*schema.Set)
+
+		// Attach the new instances first, so that the Elastic IP keeps being served while
+		// re-associating it with replacement instances.
+		for _, id := range utils.SchemaSetToStringArray(cur.Difference(old)) {
+			if err := resourceElasticIPAssociationAttach(ctx, client.Client, zone, elasticIP, id); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+
+		for _, id := range utils.SchemaSetToStringArray(old.Difference(cur)) {
+			if err := resourceElasticIPAssociationDetach(ctx, client.Client, zone, elasticIP, id); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	tflog.Debug(ctx, "update finished successfully", map[string]interface{}{
+		"id": resourceElasticIPAssociationIDString(d),
+	})
+
+	return resourceElasticIPAssociationRead(ctx, d, meta)
+}
+
+func resourceElasticIPAssociationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tflog.Debug(ctx, "beginning delete", map[string]interface{}{
+		"id": resourceElasticIPAssociationIDString(d),
+	})
+
+	zone := d.Get(resElasticIPAssociationAttrZone).(string)
+
+	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutDelete))
+	ctx = exoapi.WithEndpoint(ctx, exoapi.NewReqEndpoint(getEnvironment(meta), zone))
+	defer cancel()
+
+	client := GetComputeClient(meta)
+
+	elasticIP := &egoscale.ElasticIP{ID: utils.NonEmptyStringPtr(d.Id())}
+	for _, id := range utils.SchemaSetToStringArray(d.Get(resElasticIPAssociationAttrInstanceIDs).(*schema.Set)) {
+		if err := resourceElasticIPAssociationDetach(ctx, client.Client, zone, elasticIP, id); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	tflog.Debug(ctx, "delete finished successfully", map[string]interface{}{
+		"id": resourceElasticIPAssociationIDString(d),
+	})
+
+	return nil
+}
+
+// resourceElasticIPAssociationImport imports the association of the Elastic IP
+// with all the instances of the zone it is currently attached to, which are
+// looked up only once at import time: the instances are then only read
+// from the state.
+func resourceElasticIPAssociationImport(
+	ctx context.Context,
+	d *schema.ResourceData,
+	meta interface{},
+) ([]*schema.ResourceData, error) {
+	if _, err := zonedStateContextFunc(ctx, d, meta); err != nil {
+		return nil, err
+	}
+
+	zone := d.Get(resElasticIPAssociationAttrZone).(string)
+
+	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutRead))
+	ctx = exoapi.WithEndpoint(ctx, exoapi.NewReqEndpoint(getEnvironment(meta), zone))
+	defer cancel()
+
+	client := GetComputeClient(meta)
+
+	instances, err := client.ListInstances(ctx, zone)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list instances: %w", err)
+	}
+
+	candidates := make([]string, 0, len(instances))
+	for _, instance := range instances {
+		candidates = append(candidates, *instance.ID)
+	}
+
+	instanceIDs, err := resourceElasticIPAssociationAttachedInstances(ctx, client.Client, zone, d.Id(), candidates)
+	if err != nil {
+		return nil, err
+	}
+	if len(instanceIDs) == 0 {
+		return nil, fmt.Errorf("Elastic IP %s is not attached to any instance", d.Id())
+	}
+
+	if err := d.Set(resElasticIPAssociationAttrInstanceIDs, instanceIDs); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// resourceElasticIPAssociationAttachedInstances returns the instances among
+// instanceIDs the Elastic IP is attached to, ignoring the instances which
+// don't exist anymore.
+func resourceElasticIPAssociationAttachedInstances(
+	ctx context.Context,
+	client *egoscale.Client,
+	zone string,
+	elasticIPID string,
+	instanceIDs []string,
+) ([]string, error) {
+	attached := make([]string, 0, len(instanceIDs))
+	for _, id := range instanceIDs {
+		instance, err := client.GetInstance(ctx, zone, id)
+		if err != nil {
+			if errors.Is(err, exoapi.ErrNotFound) {
+				continue
+			}
+			return nil, fmt.Errorf("unable to retrieve instance %q: %w", id, err)
+		}
+
+		if instance.ElasticIPIDs != nil && utils.In(*instance.ElasticIPIDs, elasticIPID) {
+			attached = append(attached, id)
+		}
+	}
+
+	return attached, nil
+}
+
+func resourceElasticIPAssociationAttach(
+	ctx context.Context,
+	client *egoscale.Client,
+	zone string,
+	elasticIP *egoscale.ElasticIP,
+	instanceID string,
+) error {
+	if err := client.AttachInstanceToElasticIP(
+		ctx,
+		zone,
+		&egoscale.Instance{ID: &instanceID},
+		elasticIP,
+	); err != nil {
+		return fmt.Errorf("unable to attach Elastic IP %s to instance %s: %w", *elasticIP.ID, instanceID, err)
+	}
+
+	return nil
+}
+
+// resourceElasticIPAssociationDetach detaches the Elastic IP from the instance,
+// ignoring instances which don't exist anymore (e.g. replaced instances).
+func resourceElasticIPAssociationDetach(
+	ctx context.Context,
+	client *egoscale.Client,
+	zone string,
+	elasticIP *egoscale.ElasticIP,
+	instanceID string,
+) error {
+	if err := client.DetachInstanceFromElasticIP(
+		ctx,
+		zone,
+		&egoscale.Instance{ID: &instanceID},
+		elasticIP,
+	); err != nil && !errors.Is(err, exoapi.ErrNotFound) {
+		return fmt.Errorf("unable to detach Elastic IP %s from instance %s: %w", *elasticIP.ID, instanceID, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,154 @@
+package exoscale
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	egoscale "github.com/exoscale/egoscale/v2"
+	exoapi "github.com/exoscale/egoscale/v2/api"
+	"github.com/exoscale/terraform-provider-exoscale/pkg/utils"
+)
+
+func testAccResourceElasticIPAssociationConfig(name string, instances []string, associated []string) string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, `
+locals {
+  zone = "%s"
+}
+
+data "exoscale_compute_template" "ubuntu" {
+  zone = local.zone
+  name = "%s"
+}
+
+resource "exoscale_elastic_ip" "test" {
+  zone = local.zone
+}
+`,
+		testZoneName,
+		testInstanceTemplateName,
+	)
+
+	for _, instance := range instances {
+		fmt.Fprintf(&b, `
+resource "exoscale_compute_instance" "%[1]s" {
+  zone        = local.zone
+  name        = "%[2]s-%[1]s"
+  type        = "standard.tiny"
+  disk_size   = 10
+  template_id = data.exoscale_compute_template.ubuntu.id
+}
+`,
+			instance,
+			name,
+		)
+	}
+
+	ids := make([]string, len(associated))
+	for i, instance := range associated {
+		ids[i] = fmt.Sprintf("exoscale_compute_instance.%s.id", instance)
+	}
+
+	fmt.Fprintf(&b, `
+resource "exoscale_elastic_ip_association" "test" {
+  zone          = local.zone
+  elastic_ip_id = exoscale_elastic_ip.test.id
+  instance_ids  = [%s]
+}
+`,
+		strings.Join(ids, ", "),
+	)
+
+	return b.String()
+}
+
+func TestAccResourceElasticIPAssociation(t *testing.T) {
+	var (
+		r         = "exoscale_elastic_ip_association.test"
+		name      = acctest.RandomWithPrefix(testPrefix)
+		elasticIP egoscale.ElasticIP
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckResourceElasticIPDestroy(&elasticIP),
+		Steps: []resource.TestStep{
+			{
+				// Create
+				Config: testAccResourceElasticIPAssociationConfig(name, []string{"a", "b"}, []string{"a"}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceElasticIPExists("exoscale_elastic_ip.test", &elasticIP),
+					resource.TestCheckResourceAttr(r, resElasticIPAssociationAttrInstanceIDs+".#", "1"),
+					testAccCheckResourceElasticIPAssociated("exoscale_compute_instance.a", true),
+					testAccCheckResourceElasticIPAssociated("exoscale_compute_instance.b", false),
+				),
+			},
+			{
+				// Update
+				Config: testAccResourceElasticIPAssociationConfig(name, []string{"a", "b"}, []string{"a", "b"}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(r, resElasticIPAssociationAttrInstanceIDs+".#", "2"),
+					testAccCheckResourceElasticIPAssociated("exoscale_compute_instance.a", true),
+					testAccCheckResourceElasticIPAssociated("exoscale_compute_instance.b", true),
+				),
+			},
+			{
+				// Replace instance "a" with "c": the Elastic IP outlives the instance and is re-associated
+				Config: testAccResourceElasticIPAssociationConfig(name, []string{"b", "c"}, []string{"b", "c"}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(r, resElasticIPAssociationAttrInstanceIDs+".#", "2"),
+					testAccCheckResourceElasticIPAssociated("exoscale_compute_instance.b", true),
+					testAccCheckResourceElasticIPAssociated("exoscale_compute_instance.c", true),
+				),
+			},
+			{
+				// Import
+				ResourceName: r,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					return fmt.Sprintf("%s@%s", s.RootModule().Resources[r].Primary.ID, testZoneName), nil
+				},
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// testAccCheckResourceElasticIPAssociated checks whether the test Elastic IP is attached to the instance.
+func testAccCheckResourceElasticIPAssociated(r string, associated bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[r]
+		if !ok {
+			return errors.New("resource not found in the state")
+		}
+
+		eip, ok := s.RootModule().Resources["exoscale_elastic_ip.test"]
+		if !ok {
+			return errors.New("Elastic IP not found in the state")
+		}
+
+		client := GetComputeClient(testAccProvider.Meta())
+		ctx := exoapi.WithEndpoint(context.Background(), exoapi.NewReqEndpoint(testEnvironment, testZoneName))
+
+		instance, err := client.GetInstance(ctx, testZoneName, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		attached := instance.ElasticIPIDs != nil && utils.In(*instance.ElasticIPIDs, eip.Primary.ID)
+		if attached != associated {
+			return fmt.Errorf("instance %s: expected Elastic IP association %t, got %t", rs.Primary.ID, associated, attached)
+		}
+
+		return nil
+	}
+}
@@ -5,7 +5,9 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -232,6 +234,7 @@ func TestAccResourceElasticIP(t *testing.T) {
 		elasticIP4  egoscale.ElasticIP
 		elasticIP6  egoscale.ElasticIP
 		ElasticIPID string // After the update of healthcheck, ID must be the same
+		ElasticIPIP string // ... and so must be the address, still referenced by DNS records/firewall rules
 	)
 
 	resource.Test(t, resource.TestCase{
@@ -258,6 +261,7 @@ func TestAccResourceElasticIP(t *testing.T) {
 						a.Equal(testAccResourceElasticIPHealthcheckTimeout, int64(elasticIP4.Healthcheck.Timeout.Seconds()))
 						a.Equal(testAccResourceElasticIPHealthcheckURI, *elasticIP4.Healthcheck.URI)
 						ElasticIPID = *elasticIP4.ID
+						ElasticIPIP = elasticIP4.IPAddress.String()
 
 						return nil
 					},
@@ -287,6 +291,7 @@ func TestAccResourceElasticIP(t *testing.T) {
 
 						a.Equal(testAccResourceElasticIPDescriptionUpdated, *elasticIP4.Description)
 						a.Equal(ElasticIPID, *elasticIP4.ID)
+						a.Equal(ElasticIPIP, elasticIP4.IPAddress.String())
 						a.NotNil(elasticIP4.Healthcheck)
 						a.Equal(testAccResourceElasticIPHealthcheckIntervalUpdated, int64(elasticIP4.Healthcheck.Interval.Seconds()))
 						a.Equal(testAccResourceElasticIPHealthcheckModeUpdated, *elasticIP4.Healthcheck.Mode)
@@ -516,6 +521,36 @@ func TestAccResourceElasticIP(t *testing.T) {
 	})
 }
 
+func Test_elasticIPHealthcheckApplied(t *testing.T) {
+	var (
+		mode           = "http"
+		port    uint16 = 80
+		otherPort      = port + 1
+		uri            = "/health"
+		interval       = 10 * time.Second
+		strikes int64  = 3
+	)
+
+	expected := &egoscale.ElasticIPHealthcheck{Mode: &mode, Port: &port, URI: &uri}
+
+	assert.True(t, elasticIPHealthcheckApplied(nil, nil))
+	assert.False(t, elasticIPHealthcheckApplied(nil, expected))
+	assert.True(t, elasticIPHealthcheckApplied(
+		&egoscale.ElasticIPHealthcheck{Mode: &mode, Port: &port, URI: &uri, Interval: &interval, StrikesOK: &strikes},
+		expected,
+	))
+	assert.False(t, elasticIPHealthcheckApplied(
+		&egoscale.ElasticIPHealthcheck{Mode: &mode, Port: &otherPort, URI: &uri},
+		expected,
+	))
+
+	expected.Interval = &interval
+	assert.False(t, elasticIPHealthcheckApplied(
+		&egoscale.ElasticIPHealthcheck{Mode: &mode, Port: &port, URI: &uri},
+		expected,
+	))
+}
+
 func testAccCheckResourceElasticIPExists(r string, elasticIP *egoscale.ElasticIP) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[r]
@@ -557,3 +592,22 @@ func testAccCheckResourceElasticIPDestroy(elasticIP *egoscale.ElasticIP) resourc
 		return errors.New("Elastic IP still exists")
 	}
 }
+
+func Test_resourceElasticIPUpdatableInPlace(t *testing.T) {
+	s := resourceElasticIP().Schema
+
+	// Replacing an Elastic IP changes its address, breaking the DNS records and
+	// firewall rules referencing it: these attributes must be updated in place.
+	for _, k := range []string{
+		resElasticIPAttrDescription,
+		"healthcheck",
+		resElasticIPAttrLabels,
+		resElasticIPAttrReverseDNS,
+	} {
+		assert.False(t, s[k].ForceNew, k)
+	}
+
+	for k, v := range s["healthcheck"].Elem.(*schema.Resource).Schema {
+		assert.False(t, v.ForceNew, k)
+	}
+}
@@ -156,7 +156,7 @@ func resourceIAMAccessKeyCreate(ctx context.Context, d *schema.ResourceData, met
 	})
 
 	name := d.Get(resIAMAccessKeyAttrName).(string)
-	zone := defaultZone
+	zone := getDefaultZone(meta)
 
 	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutCreate))
 	ctx = exoapi.WithEndpoint(ctx, exoapi.NewReqEndpoint(getEnvironment(meta), zone))
@@ -223,7 +223,7 @@ func resourceIAMAccessKeyRead(ctx context.Context, d *schema.ResourceData, meta
 		"id": resourceIAMAccessKeyIDString(d),
 	})
 
-	zone := defaultZone
+	zone := getDefaultZone(meta)
 
 	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutRead))
 	ctx = exoapi.WithEndpoint(ctx, exoapi.NewReqEndpoint(getEnvironment(meta), zone))
@@ -253,7 +253,7 @@ func resourceIAMAccessKeyDelete(ctx context.Context, d *schema.ResourceData, met
 		"id": resourceIAMAccessKeyIDString(d),
 	})
 
-	zone := defaultZone
+	zone := getDefaultZone(meta)
 
 	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutDelete))
 	ctx = exoapi.WithEndpoint(ctx, exoapi.NewReqEndpoint(getEnvironment(meta), zone))
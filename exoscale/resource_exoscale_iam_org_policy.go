@@ -0,0 +1,478 @@
+package exoscale
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	exoapi "github.com/exoscale/egoscale/v2/api"
+	"github.com/exoscale/egoscale/v2/oapi"
+	"github.com/exoscale/terraform-provider-exoscale/pkg/config"
+	"github.com/exoscale/terraform-provider-exoscale/pkg/general"
+)
+
+const (
+	resIAMOrgPolicyAttrPolicy = "policy"
+
+	// resIAMOrgPolicyID is the fixed ID of the (singleton) organization policy resource.
+	resIAMOrgPolicyID = "organization-policy"
+)
+
+func resourceIAMOrgPolicyIDString(d general.ResourceIDStringer) string {
+	return general.ResourceIDString(d, "exoscale_iam_org_policy")
+}
+
+func resourceIAMOrgPolicy() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			resIAMOrgPolicyAttrPolicy: {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateDiagFunc: func(i interface{}, _ cty.Path) diag.Diagnostics {
+					_, err := normalizeIAMPolicy(i.(string))
+					return diag.FromErr(err)
+				},
+				DiffSuppressFunc: func(_, old, new string, _ *schema.ResourceData) bool {
+					return iamPoliciesEquivalent(old, new)
+				},
+				Description: "The organization policy JSON document (`default-service-strategy` and per-service `services` policies).",
+			},
+		},
+
+		CreateContext: resourceIAMOrgPolicyCreate,
+		ReadContext:   resourceIAMOrgPolicyRead,
+		UpdateContext: resourceIAMOrgPolicyUpdate,
+		DeleteContext: resourceIAMOrgPolicyDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceIAMOrgPolicyImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(config.DefaultTimeout),
+			Read:   schema.DefaultTimeout(config.DefaultTimeout),
+			Update: schema.DefaultTimeout(config.DefaultTimeout),
+			Delete: schema.DefaultTimeout(config.DefaultTimeout),
+		},
+	}
+}
+
+func resourceIAMOrgPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tflog.Debug(ctx, "beginning create", map[string]interface{}{
+		"id": resourceIAMOrgPolicyIDString(d),
+	})
+
+	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutCreate))
+	defer cancel()
+
+	if err := updateIAMOrgPolicy(ctx, meta, d.Get(resIAMOrgPolicyAttrPolicy).(string)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(resIAMOrgPolicyID)
+
+	tflog.Debug(ctx, "create finished successfully", map[string]interface{}{
+		"id": resourceIAMOrgPolicyIDString(d),
+	})
+
+	return resourceIAMOrgPolicyRead(ctx, d, meta)
+}
+
+func resourceIAMOrgPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tflog.Debug(ctx, "beginning read", map[string]interface{}{
+		"id": resourceIAMOrgPolicyIDString(d),
+	})
+
+	zone := getDefaultZone(meta)
+
+	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutRead))
+	ctx = exoapi.WithEndpoint(ctx, exoapi.NewReqEndpoint(getEnvironment(meta), zone))
+	defer cancel()
+
+	client := GetComputeClient(meta)
+
+	resp, err := client.Client.GetIamOrganizationPolicyWithResponse(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if resp.StatusCode() != 200 {
+		return diag.Errorf("unable to retrieve IAM organization policy: unexpected response status %s", resp.Status())
+	}
+
+	// The API returns a single policy object: decode the raw body rather than
+	// relying on the generated JSON200 field (typed as a list).
+	var orgPolicy oapi.IamPolicy
+	if err := json.Unmarshal(resp.Body, &orgPolicy); err != nil {
+		return diag.Errorf("unable to parse IAM organization policy: %v", err)
+	}
+
+	policy, err := json.Marshal(orgPolicy)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Preserve the configured formatting as long as the policies are equivalent.
+	if !iamPoliciesEquivalent(d.Get(resIAMOrgPolicyAttrPolicy).(string), string(policy)) {
+		if err := d.Set(resIAMOrgPolicyAttrPolicy, string(policy)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	tflog.Debug(ctx, "read finished successfully", map[string]interface{}{
+		"id": resourceIAMOrgPolicyIDString(d),
+	})
+
+	return nil
+}
+
+func resourceIAMOrgPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tflog.Debug(ctx, "beginning update", map[string]interface{}{
+		"id": resourceIAMOrgPolicyIDString(d),
+	})
+
+	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutUpdate))
+	defer cancel()
+
+	if d.HasChange(resIAMOrgPolicyAttrPolicy) {
+		if err := updateIAMOrgPolicy(ctx, meta, d.Get(resIAMOrgPolicyAttrPolicy).(string)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	tflog.Debug(ctx, "update finished successfully", map[string]interface{}{
+		"id": resourceIAMOrgPolicyIDString(d),
+	})
+
+	return resourceIAMOrgPolicyRead(ctx, d, meta)
+}
+
+// resourceIAMOrgPolicyDelete resets the organization policy to the API
+// default, which allows access to all services.
+func resourceIAMOrgPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tflog.Debug(ctx, "beginning delete", map[string]interface{}{
+		"id": resourceIAMOrgPolicyIDString(d),
+	})
+
+	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutDelete))
+	defer cancel()
+
+	if err := updateIAMOrgPolicy(ctx, meta, `{"default-service-strategy":"allow","services":{}}`); err != nil {
+		return diag.FromErr(err)
+	}
+
+	tflog.Debug(ctx, "delete finished successfully", map[string]interface{}{
+		"id": resourceIAMOrgPolicyIDString(d),
+	})
+
+	return nil
+}
+
+// resourceIAMOrgPolicyImport accepts any import ID, as there is only one
+// organization policy per organization.
+func resourceIAMOrgPolicyImport(
+	_ context.Context,
+	d *schema.ResourceData,
+	_ interface{},
+) ([]*schema.ResourceData, error) {
+	d.SetId(resIAMOrgPolicyID)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func updateIAMOrgPolicy(ctx context.Context, meta interface{}, policy string) error {
+	var body oapi.UpdateIamOrganizationPolicyJSONRequestBody
+	if err := json.Unmarshal([]byte(policy), &body); err != nil {
+		return fmt.Errorf("unable to parse IAM organization policy: %w", err)
+	}
+
+	zone := getDefaultZone(meta)
+	ctx = exoapi.WithEndpoint(ctx, exoapi.NewReqEndpoint(getEnvironment(meta), zone))
+
+	client := GetComputeClient(meta)
+
+	resp, err := client.Client.UpdateIamOrganizationPolicyWithResponse(ctx, body)
+	if err != nil {
+		// The rules expressions are only validated by the API: report its
+		// validation errors as such rather than as a generic request failure.
+		if errors.Is(err, exoapi.ErrInvalidRequest) {
+			return fmt.Errorf("IAM organization policy rejected by the API (check the rules expressions): %w", err)
+		}
+		return fmt.Errorf("unable to update IAM organization policy: %w", err)
+	}
+	if resp.JSON200 == nil {
+		return fmt.Errorf("unable to update IAM organization policy: unexpected response status %s", resp.Status())
+	}
+
+	if _, err := oapi.NewPoller().Poll(ctx, oapi.OperationPoller(client.Client, zone, *resp.JSON200.Id)); err != nil {
+		return fmt.Errorf("unable to update IAM organization policy: %w", err)
+	}
+
+	return nil
+}
+
+// normalizeIAMPolicy validates an IAM policy JSON document and returns its
+// canonical representation (services sorted by name, no insignificant whitespace).
+func normalizeIAMPolicy(policy string) (string, error) {
+	var p oapi.IamPolicy
+
+	dec := json.NewDecoder(bytes.NewBufferString(policy))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&p); err != nil {
+		return "", fmt.Errorf("invalid policy JSON: %w", err)
+	}
+
+	switch p.DefaultServiceStrategy {
+	case oapi.IamPolicyDefaultServiceStrategyAllow, oapi.IamPolicyDefaultServiceStrategyDeny:
+	default:
+		return "", fmt.Errorf(
+			"invalid default-service-strategy %q: must be one of %q, %q",
+			p.DefaultServiceStrategy,
+			oapi.IamPolicyDefaultServiceStrategyAllow,
+			oapi.IamPolicyDefaultServiceStrategyDeny,
+		)
+	}
+
+	for name, service := range p.Services.AdditionalProperties {
+		if err := validateIAMServicePolicy(service); err != nil {
+			return "", fmt.Errorf("invalid policy for service %q: %w", name, err)
+		}
+	}
+
+	normalized, err := json.Marshal(p)
+	if err != nil {
+		return "", err
+	}
+
+	return string(normalized), nil
+}
+
+func validateIAMServicePolicy(service oapi.IamServicePolicy) error {
+	if service.Type == nil {
+		return errors.New("missing type")
+	}
+
+	switch *service.Type {
+	case oapi.IamServicePolicyTypeAllow, oapi.IamServicePolicyTypeDeny:
+		if service.Rules != nil && len(*service.Rules) > 0 {
+			return fmt.Errorf("rules are only supported with type %q", oapi.IamServicePolicyTypeRules)
+		}
+	case oapi.IamServicePolicyTypeRules:
+		if service.Rules == nil || len(*service.Rules) == 0 {
+			return fmt.Errorf("type %q requires at least one rule", oapi.IamServicePolicyTypeRules)
+		}
+	default:
+		return fmt.Errorf(
+			"invalid type %q: must be one of %q, %q, %q",
+			*service.Type,
+			oapi.IamServicePolicyTypeAllow,
+			oapi.IamServicePolicyTypeDeny,
+			oapi.IamServicePolicyTypeRules,
+		)
+	}
+
+	if service.Rules == nil {
+		return nil
+	}
+
+	for i, rule := range *service.Rules {
+		if rule.Action == nil ||
+			(*rule.Action != oapi.IamServicePolicyRuleActionAllow && *rule.Action != oapi.IamServicePolicyRuleActionDeny) {
+			return fmt.Errorf(
+				"rule #%d: action must be one of %q, %q",
+				i,
+				oapi.IamServicePolicyRuleActionAllow,
+				oapi.IamServicePolicyRuleActionDeny,
+			)
+		}
+
+		if rule.Expression == nil || *rule.Expression == "" {
+			return fmt.Errorf("rule #%d: missing expression", i)
+		}
+
+		if err := validateIAMRuleExpression(*rule.Expression); err != nil {
+			return fmt.Errorf("rule #%d: invalid expression: %w", i, err)
+		}
+	}
+
+	return nil
+}
+
+// iamExpressionBrackets maps the closing brackets of rule expressions to their
+// opening counterpart.
+var iamExpressionBrackets = map[byte]byte{')': '(', ']': '[', '}': '{'}
+
+// validateIAMRuleExpression performs a lexical validation of an IAM policy rule
+// expression, the API being in charge of its full validation: string literals
+// must be terminated, brackets balanced, and the resource labels (tags)
+// conditions must reference valid label keys, i.e. either identifiers
+// (labels.project) or non-empty string literals (labels['my-project']).
+func validateIAMRuleExpression(expr string) error {
+	stack := make([]byte, 0)
+
+	for i := 0; i < len(expr); i++ {
+		c := expr[i]
+
+		switch {
+		case c == '\'' || c == '"':
+			end, err := iamExpressionStringEnd(expr, i, false)
+			if err != nil {
+				return err
+			}
+			i = end
+
+		case c == '(' || c == '[' || c == '{':
+			stack = append(stack, c)
+
+		case c == ')' || c == ']' || c == '}':
+			if len(stack) == 0 || stack[len(stack)-1] != iamExpressionBrackets[c] {
+				return fmt.Errorf("unexpected %q at position %d", c, i)
+			}
+			stack = stack[:len(stack)-1]
+
+		case iamExpressionIdentStart(c):
+			end := iamExpressionIdentEnd(expr, i)
+			ident := expr[i:end]
+
+			// Raw string literal (r'...')
+			if (ident == "r" || ident == "R") && end < len(expr) && (expr[end] == '\'' || expr[end] == '"') {
+				strEnd, err := iamExpressionStringEnd(expr, end, true)
+				if err != nil {
+					return err
+				}
+				i = strEnd
+				continue
+			}
+
+			if ident == "labels" && i > 0 && expr[i-1] == '.' {
+				if err := validateIAMLabelsSelector(expr, end); err != nil {
+					return err
+				}
+			}
+			i = end - 1
+		}
+	}
+
+	if len(stack) > 0 {
+		return fmt.Errorf("unclosed %q", stack[len(stack)-1])
+	}
+
+	return nil
+}
+
+// validateIAMLabelsSelector validates the label key selected right after the
+// labels field ending at position pos of the expression.
+func validateIAMLabelsSelector(expr string, pos int) error {
+	pos = iamExpressionSkipSpaces(expr, pos)
+	if pos >= len(expr) {
+		return nil
+	}
+
+	switch expr[pos] {
+	case '.':
+		start := iamExpressionSkipSpaces(expr, pos+1)
+		if start >= len(expr) || !iamExpressionIdentStart(expr[start]) {
+			return fmt.Errorf("missing label key after %q at position %d", "labels.", pos)
+		}
+		end := iamExpressionIdentEnd(expr, start)
+
+		// A dash is parsed as a subtraction: such label keys must be quoted.
+		if end+1 < len(expr) && expr[end] == '-' && iamExpressionIdentStart(expr[end+1]) {
+			key := expr[start:iamExpressionLabelKeyEnd(expr, start)]
+			return fmt.Errorf("label key %q must be quoted: use labels['%s']", key, key)
+		}
+
+	case '[':
+		start := iamExpressionSkipSpaces(expr, pos+1)
+		if start >= len(expr) || (expr[start] != '\'' && expr[start] != '"') {
+			// Dynamic label key, only known at evaluation time.
+			return nil
+		}
+
+		end, err := iamExpressionStringEnd(expr, start, false)
+		if err != nil {
+			return err
+		}
+		if strings.TrimSpace(expr[start+1:end]) == "" {
+			return fmt.Errorf("empty label key at position %d", start)
+		}
+	}
+
+	return nil
+}
+
+// iamExpressionStringEnd returns the position of the quote terminating the string
+// literal starting at position start of the expression.
+func iamExpressionStringEnd(expr string, start int, raw bool) (int, error) {
+	quote := expr[start : start+1]
+	if strings.HasPrefix(expr[start:], strings.Repeat(quote, 3)) {
+		quote = strings.Repeat(quote, 3)
+	}
+
+	for i := start + len(quote); i < len(expr); i++ {
+		if expr[i] == '\\' && !raw {
+			i++
+			continue
+		}
+		if strings.HasPrefix(expr[i:], quote) {
+			return i + len(quote) - 1, nil
+		}
+	}
+
+	return 0, fmt.Errorf("unterminated string literal at position %d", start)
+}
+
+func iamExpressionSkipSpaces(expr string, pos int) int {
+	for pos < len(expr) && strings.ContainsRune(" \t\r\n", rune(expr[pos])) {
+		pos++
+	}
+
+	return pos
+}
+
+func iamExpressionIdentStart(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+func iamExpressionIdentEnd(expr string, start int) int {
+	end := start
+	for end < len(expr) && (iamExpressionIdentStart(expr[end]) || (expr[end] >= '0' && expr[end] <= '9')) {
+		end++
+	}
+
+	return end
+}
+
+// iamExpressionLabelKeyEnd returns the end of an unquoted label key containing
+// dashes (e.g. labels.my-project), for error reporting purposes.
+func iamExpressionLabelKeyEnd(expr string, start int) int {
+	end := iamExpressionIdentEnd(expr, start)
+	for end+1 < len(expr) && expr[end] == '-' && iamExpressionIdentStart(expr[end+1]) {
+		end = iamExpressionIdentEnd(expr, end+1)
+	}
+
+	return end
+}
+
+// iamPoliciesEquivalent returns true if both IAM policy JSON documents are
+// semantically equal, i.e. only differ in formatting or services ordering.
+func iamPoliciesEquivalent(a, b string) bool {
+	na, err := normalizeIAMPolicy(a)
+	if err != nil {
+		return false
+	}
+
+	nb, err := normalizeIAMPolicy(b)
+	if err != nil {
+		return false
+	}
+
+	return na == nb
+}
@@ -0,0 +1,289 @@
+package exoscale
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/require"
+
+	exoapi "github.com/exoscale/egoscale/v2/api"
+	"github.com/exoscale/egoscale/v2/oapi"
+)
+
+var (
+	testAccResourceIAMOrgPolicyConfigCreate = `
+resource "exoscale_iam_org_policy" "test" {
+  policy = jsonencode({
+    default-service-strategy = "allow"
+    services = {
+      sos = {
+        type = "allow"
+      }
+    }
+  })
+}
+`
+
+	// Same policy as above, only differing in formatting and key ordering.
+	testAccResourceIAMOrgPolicyConfigReformatted = `
+resource "exoscale_iam_org_policy" "test" {
+  policy = <<EOT
+{
+  "services": { "sos": { "type": "allow" } },
+  "default-service-strategy": "allow"
+}
+EOT
+}
+`
+
+	testAccResourceIAMOrgPolicyConfigUpdate = `
+resource "exoscale_iam_org_policy" "test" {
+  policy = jsonencode({
+    default-service-strategy = "allow"
+    services = {
+      sos = {
+        type = "rules"
+        rules = [{
+          action     = "allow"
+          expression = "operation in ['list-sos-buckets-usage', 'list-buckets']"
+        }]
+      }
+    }
+  })
+}
+`
+)
+
+func TestAccResourceIAMOrgPolicy(t *testing.T) {
+	r := "exoscale_iam_org_policy.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckResourceIAMOrgPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				// Create
+				Config: testAccResourceIAMOrgPolicyConfigCreate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceIAMOrgPolicyServiceType("sos", oapi.IamServicePolicyTypeAllow),
+					resource.TestCheckResourceAttr(r, "id", resIAMOrgPolicyID),
+				),
+			},
+			{
+				// Semantically equivalent policy: no changes expected
+				Config:   testAccResourceIAMOrgPolicyConfigReformatted,
+				PlanOnly: true,
+			},
+			{
+				// Update
+				Config: testAccResourceIAMOrgPolicyConfigUpdate,
+				Check:  testAccCheckResourceIAMOrgPolicyServiceType("sos", oapi.IamServicePolicyTypeRules),
+			},
+			{
+				// Import
+				ResourceName:      r,
+				ImportState:       true,
+				ImportStateId:     "org",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func Test_normalizeIAMPolicy(t *testing.T) {
+	tests := []struct {
+		name    string
+		policy  string
+		want    string
+		wantErr bool
+	}{
+		{
+			name:   "minimal",
+			policy: `{"default-service-strategy": "deny", "services": {}}`,
+			want:   `{"default-service-strategy":"deny","services":{}}`,
+		},
+		{
+			name: "services are sorted",
+			policy: `{
+  "services": {
+    "sos": {"type": "deny"},
+    "dbaas": {"rules": [{"expression": "true", "action": "allow"}], "type": "rules"}
+  },
+  "default-service-strategy": "allow"
+}`,
+			want: `{"default-service-strategy":"allow","services":{"dbaas":{"rules":[{"action":"allow","expression":"true"}],"type":"rules"},"sos":{"type":"deny"}}}`,
+		},
+		{name: "invalid JSON", policy: `{`, wantErr: true},
+		{name: "unknown field", policy: `{"default-service-strategy": "allow", "foo": 1}`, wantErr: true},
+		{name: "invalid strategy", policy: `{"default-service-strategy": "maybe"}`, wantErr: true},
+		{
+			name:    "missing service type",
+			policy:  `{"default-service-strategy": "allow", "services": {"sos": {}}}`,
+			wantErr: true,
+		},
+		{
+			name:    "rules without rules type",
+			policy:  `{"default-service-strategy": "allow", "services": {"sos": {"type": "deny", "rules": [{"action": "allow", "expression": "true"}]}}}`,
+			wantErr: true,
+		},
+		{
+			name:    "rules type without rules",
+			policy:  `{"default-service-strategy": "allow", "services": {"sos": {"type": "rules"}}}`,
+			wantErr: true,
+		},
+		{
+			name:    "invalid rule action",
+			policy:  `{"default-service-strategy": "allow", "services": {"sos": {"type": "rules", "rules": [{"action": "drop", "expression": "true"}]}}}`,
+			wantErr: true,
+		},
+		{
+			name:    "missing rule expression",
+			policy:  `{"default-service-strategy": "allow", "services": {"sos": {"type": "rules", "rules": [{"action": "deny"}]}}}`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := normalizeIAMPolicy(tt.policy)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_validateIAMRuleExpression(t *testing.T) {
+	tests := []struct {
+		name    string
+		expr    string
+		wantErr string
+	}{
+		{name: "operation", expr: "operation.startsWith('get-') || operation.startsWith('list-')"},
+		{name: "label selector", expr: "resources.instance.labels.project == 'web'"},
+		{name: "quoted label key", expr: `resources.instance.labels["my-project"] == "web"`},
+		{name: "label presence", expr: "'project' in resources.instance.labels"},
+		{name: "dynamic label key", expr: "resources.instance.labels[parameters.key] == 'web'"},
+		{name: "raw string", expr: `r'\d+' == parameters.name`},
+		{name: "triple-quoted string", expr: `parameters.name == """it's"""`},
+		{
+			name:    "unquoted label key with dashes",
+			expr:    "resources.instance.labels.my-project == 'web'",
+			wantErr: `label key "my-project" must be quoted: use labels['my-project']`,
+		},
+		{
+			name:    "empty label key",
+			expr:    "resources.instance.labels[''] == 'web'",
+			wantErr: "empty label key",
+		},
+		{
+			name:    "missing label key",
+			expr:    "resources.instance.labels. == 'web'",
+			wantErr: "missing label key",
+		},
+		{
+			name:    "unterminated string",
+			expr:    "resources.instance.labels.project == 'web",
+			wantErr: "unterminated string literal",
+		},
+		{
+			name:    "unbalanced brackets",
+			expr:    "(operation == 'get-instance'",
+			wantErr: "unclosed",
+		},
+		{
+			name:    "unexpected bracket",
+			expr:    "operation == 'get-instance')",
+			wantErr: "unexpected",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateIAMRuleExpression(tt.expr)
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func Test_iamPoliciesEquivalent(t *testing.T) {
+	a := `{"default-service-strategy":"allow","services":{"sos":{"type":"deny"},"dbaas":{"type":"allow"}}}`
+	b := `{
+  "services": {
+    "dbaas": { "type": "allow" },
+    "sos":   { "type": "deny" }
+  },
+  "default-service-strategy": "allow"
+}`
+
+	require.True(t, iamPoliciesEquivalent(a, b))
+	require.False(t, iamPoliciesEquivalent(a, `{"default-service-strategy":"deny","services":{"sos":{"type":"deny"},"dbaas":{"type":"allow"}}}`))
+	require.False(t, iamPoliciesEquivalent(a, `{`))
+}
+
+func testAccGetIAMOrgPolicy() (*oapi.IamPolicy, error) {
+	client := GetComputeClient(testAccProvider.Meta())
+	ctx := exoapi.WithEndpoint(context.Background(), exoapi.NewReqEndpoint(testEnvironment, testZoneName))
+
+	resp, err := client.Client.GetIamOrganizationPolicyWithResponse(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode() != 200 {
+		return nil, fmt.Errorf("unexpected response status %s", resp.Status())
+	}
+
+	var policy oapi.IamPolicy
+	if err := json.Unmarshal(resp.Body, &policy); err != nil {
+		return nil, err
+	}
+
+	return &policy, nil
+}
+
+func testAccCheckResourceIAMOrgPolicyServiceType(service string, expected oapi.IamServicePolicyType) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		policy, err := testAccGetIAMOrgPolicy()
+		if err != nil {
+			return err
+		}
+
+		servicePolicy, ok := policy.Services.Get(service)
+		if !ok {
+			return fmt.Errorf("no policy found for service %q", service)
+		}
+
+		if servicePolicy.Type == nil || *servicePolicy.Type != expected {
+			return fmt.Errorf("expected service %q policy type %q, got %v", service, expected, servicePolicy.Type)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckResourceIAMOrgPolicyDestroy(_ *terraform.State) error {
+	policy, err := testAccGetIAMOrgPolicy()
+	if err != nil {
+		return err
+	}
+
+	if policy.DefaultServiceStrategy != oapi.IamPolicyDefaultServiceStrategyAllow ||
+		len(policy.Services.AdditionalProperties) > 0 {
+		return errors.New("IAM organization policy has not been reset")
+	}
+
+	return nil
+}
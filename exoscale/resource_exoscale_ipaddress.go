@@ -14,6 +14,7 @@ import (
 	"github.com/exoscale/egoscale"
 	"github.com/exoscale/terraform-provider-exoscale/pkg/config"
 	"github.com/exoscale/terraform-provider-exoscale/pkg/general"
+	"github.com/exoscale/terraform-provider-exoscale/pkg/utils"
 )
 
 func resourceIPAddressIDString(d general.ResourceIDStringer) string {
@@ -23,10 +24,11 @@ func resourceIPAddressIDString(d general.ResourceIDStringer) string {
 func resourceIPAddress() *schema.Resource {
 	s := map[string]*schema.Schema{
 		"zone": {
-			Type:        schema.TypeString,
-			Required:    true,
-			ForceNew:    true,
-			Description: "The Exoscale Zone name",
+			Type:             schema.TypeString,
+			Required:         true,
+			ForceNew:         true,
+			Description:      "The Exoscale Zone name",
+			ValidateDiagFunc: utils.ValidateZone(),
 		},
 		"healthcheck_mode": {
 			Type:         schema.TypeString,
@@ -111,6 +113,8 @@ func resourceIPAddress() *schema.Resource {
 		Delete: resourceIPAddressDelete,
 		Exists: resourceIPAddressExists,
 
+		CustomizeDiff: utils.QuotaCustomizeDiff("elastic-ip"),
+
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -2,19 +2,34 @@ package exoscale
 
 import (
 	"context"
+	"encoding/binary"
 	"errors"
 	"fmt"
 	"net"
+	"strings"
+	"time"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/exoscale/egoscale"
+	exoapi "github.com/exoscale/egoscale/v2/api"
+	"github.com/exoscale/egoscale/v2/oapi"
 	"github.com/exoscale/terraform-provider-exoscale/pkg/config"
 	"github.com/exoscale/terraform-provider-exoscale/pkg/general"
+	"github.com/exoscale/terraform-provider-exoscale/pkg/utils"
 )
 
+// resourceNetworkTagsRetryBackoff is the initial delay between network tags
+// requests attempts failing with a transient error.
+var resourceNetworkTagsRetryBackoff = time.Second
+
+// resourceNetworkTagsMaxAttempts is the maximum number of network tags requests attempts.
+const resourceNetworkTagsMaxAttempts = 4
+
 func resourceNetworkIDString(d general.ResourceIDStringer) string {
 	return general.ResourceIDString(d, "exoscale_network")
 }
@@ -22,10 +37,11 @@ func resourceNetworkIDString(d general.ResourceIDStringer) string {
 func resourceNetwork() *schema.Resource {
 	s := map[string]*schema.Schema{
 		"zone": {
-			Type:        schema.TypeString,
-			Required:    true,
-			ForceNew:    true,
-			Description: "The Exoscale Zone name.",
+			Type:             schema.TypeString,
+			Required:         true,
+			ForceNew:         true,
+			Description:      "The Exoscale Zone name.",
+			ValidateDiagFunc: utils.ValidateZone(),
 		},
 		"network_offering": {
 			Type:       schema.TypeString,
@@ -41,43 +57,67 @@ func resourceNetwork() *schema.Resource {
 			Type:        schema.TypeString,
 			Optional:    true,
 			Computed:    true,
-			Description: "A free-form text describing the network.",
+			Description: "A free-form text describing the network (defaults to the network name; set to `\"\"` to clear it).",
 		},
 		"start_ip": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			ValidateFunc: validation.IsIPAddress,
-			Description:  "The first/last IP addresses used by the DHCP service for dynamic leases. Required for *managed* private networks.",
+			Type:             schema.TypeString,
+			Optional:         true,
+			ValidateFunc:     validation.IsIPAddress,
+			ConflictsWith:    []string{"cidr"},
+			DiffSuppressFunc: resourceNetworkSuppressCIDRDerivedDiff,
+			Description:      "The first/last IP addresses used by the DHCP service for dynamic leases. Required for *managed* private networks, unless `cidr` is set.",
 		},
 		"end_ip": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			ValidateFunc: validation.IsIPAddress,
-			Description:  "The first/last IP addresses used by the DHCP service for dynamic leases. Required for *managed* private networks.",
+			Type:             schema.TypeString,
+			Optional:         true,
+			ValidateFunc:     validation.IsIPAddress,
+			ConflictsWith:    []string{"cidr"},
+			DiffSuppressFunc: resourceNetworkSuppressCIDRDerivedDiff,
+			Description:      "The first/last IP addresses used by the DHCP service for dynamic leases. Required for *managed* private networks, unless `cidr` is set.",
 		},
 		"netmask": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			ValidateFunc:     validation.IsIPAddress,
+			ConflictsWith:    []string{"cidr"},
+			DiffSuppressFunc: resourceNetworkSuppressCIDRDerivedDiff,
+			Description:      "The network mask defining the IP network allowed for static leases (see `exoscale_nic` resource). Required for *managed* private networks, unless `cidr` is set.",
+		},
+		"cidr": {
 			Type:         schema.TypeString,
 			Optional:     true,
-			ValidateFunc: validation.IsIPAddress,
-			Description:  "The network mask defining the IP network allowed for static leases (see `exoscale_nic` resource). Required for *managed* private networks.",
+			ValidateFunc: validation.IsCIDRNetwork(0, 30),
+			Description:  "The IPv4 network of a *managed* private network (e.g. `10.0.0.0/24`), as an alternative to `start_ip`, `end_ip` and `netmask` which are then derived from it.",
+		},
+		"dhcp_range_size": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+			RequiredWith: []string{"cidr"},
+			Description:  "The number of addresses used by the DHCP service for dynamic leases, starting at the first usable address of `cidr` (defaults to all the usable addresses). The remaining addresses can be used for static leases.",
 		},
 	}
 
 	addTags(s, "tags")
 
+	s[utils.AttrWaitForDeletion] = utils.WaitForDeletionSchema()
+
 	return &schema.Resource{
 		Schema: s,
 
-		Description: "Manage Exoscale Private Networks.",
+		Description:        "Manage Exoscale Private Networks.",
+		DeprecationMessage: "!> **WARNING:** This resource is **DEPRECATED** and will be removed in the next major version. Please use [exoscale_private_network](./private_network.md) instead.",
+
+		CreateContext: resourceNetworkCreate,
+		Read:          resourceNetworkRead,
+		UpdateContext: resourceNetworkUpdate,
+		Delete:        resourceNetworkDelete,
+		Exists:        resourceNetworkExists,
 
-		Create: resourceNetworkCreate,
-		Read:   resourceNetworkRead,
-		Update: resourceNetworkUpdate,
-		Delete: resourceNetworkDelete,
-		Exists: resourceNetworkExists,
+		CustomizeDiff: resourceNetworkCustomizeDiff,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceNetworkImport,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -89,12 +129,12 @@ func resourceNetwork() *schema.Resource {
 	}
 }
 
-func resourceNetworkCreate(d *schema.ResourceData, meta interface{}) error {
-	tflog.Debug(context.Background(), "beginning create", map[string]interface{}{
+func resourceNetworkCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tflog.Debug(ctx, "beginning create", map[string]interface{}{
 		"id": resourceNetworkIDString(d),
 	})
 
-	ctx, cancel := context.WithTimeout(context.Background(), d.Timeout(schema.TimeoutCreate))
+	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutCreate))
 	defer cancel()
 
 	client := GetComputeClient(meta)
@@ -108,41 +148,47 @@ func resourceNetworkCreate(d *schema.ResourceData, meta interface{}) error {
 	zoneName := d.Get("zone").(string)
 	zone, err := getZoneByName(ctx, client, zoneName)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	startIP := net.ParseIP(d.Get("start_ip").(string))
-	endIP := net.ParseIP(d.Get("end_ip").(string))
-	netmask := net.ParseIP(d.Get("netmask").(string))
-	if (startIP == nil && endIP != nil) || (startIP != nil && endIP == nil) {
-		return errors.New("start_ip and end_ip must be both specified")
-	} else if (startIP != nil && endIP != nil) && netmask == nil {
-		return errors.New("netmask must be specified with start_ip and end_ip")
+	startIP, endIP, netmask, diags := resourceNetworkManagedSettings(d)
+	if diags.HasError() {
+		return diags
 	}
 
 	req := &egoscale.CreateNetwork{
 		Name:        name,
 		DisplayText: displayText,
 		ZoneID:      zone.ID,
-		StartIP:     startIP,
-		EndIP:       endIP,
-		Netmask:     netmask,
+		StartIP:     net.ParseIP(startIP),
+		EndIP:       net.ParseIP(endIP),
+		Netmask:     net.ParseIP(netmask),
 	}
 
 	resp, err := client.RequestWithContext(ctx, req)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	network := resp.(*egoscale.Network)
 	d.SetId(network.ID.String())
 
+	// The API requires a display text at creation time: clear it afterwards if
+	// explicitly set to an empty string.
+	if resourceNetworkDisplayTextCleared(d.GetRawConfig()) {
+		if err := resourceNetworkClearDisplayText(ctx, meta, zoneName, network.ID.String()); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	cmd, err := createTags(d, "tags", network.ResourceType())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if cmd != nil {
-		if err := client.BooleanRequestWithContext(ctx, cmd); err != nil {
+		if err := resourceNetworkTagsWithRetry(ctx, func(ctx context.Context) error {
+			return client.BooleanRequestWithContext(ctx, cmd)
+		}); err != nil {
 			// Attempting to destroy the freshly created network
 			e := client.BooleanRequestWithContext(ctx, &egoscale.DeleteNetwork{
 				ID: network.ID,
@@ -154,7 +200,7 @@ func resourceNetworkCreate(d *schema.ResourceData, meta interface{}) error {
 				})
 			}
 
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -162,7 +208,280 @@ func resourceNetworkCreate(d *schema.ResourceData, meta interface{}) error {
 		"id": resourceNetworkIDString(d),
 	})
 
-	return resourceNetworkRead(d, meta)
+	return diag.FromErr(resourceNetworkRead(d, meta))
+}
+
+// resourceNetworkReplacementImpacts describes the impact of replacing a network
+// following a change of one of its ForceNew attributes.
+var resourceNetworkReplacementImpacts = map[string]string{
+	"zone": "the network is deleted and a new one (with a new ID) is created in the new zone, the attached instances being detached from it",
+}
+
+func resourceNetworkCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	utils.WarnReplacement(ctx, d, resourceNetworkReplacementImpacts)
+
+	if d.Id() == "" {
+		if err := utils.CheckQuotaDiff(ctx, d, meta, "private-network", 1); err != nil {
+			return err
+		}
+	}
+
+	// Values depending on other resources are only known at apply time.
+	for _, key := range []string{"start_ip", "end_ip", "netmask", "cidr", "dhcp_range_size"} {
+		if !d.NewValueKnown(key) {
+			return nil
+		}
+	}
+
+	if _, _, _, diags := resourceNetworkManagedSettings(d); diags.HasError() {
+		// CustomizeDiff cannot return diagnostics: the attribute paths are
+		// only reported at apply time.
+		return networkDiagnosticsError(diags)
+	}
+
+	// display_text is computed (defaulting to the network name): an empty string
+	// must be planned explicitly for the display text to be cleared.
+	if d.Id() != "" && d.Get("display_text").(string) != "" && resourceNetworkDisplayTextCleared(d.GetRawConfig()) {
+		if err := d.SetNew("display_text", ""); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// resourceNetworkSuppressCIDRDerivedDiff suppresses the start_ip, end_ip and netmask
+// diffs when the network is managed through cidr, these attributes then being
+// refreshed with the values derived from it.
+func resourceNetworkSuppressCIDRDerivedDiff(_, _, _ string, d *schema.ResourceData) bool {
+	return resourceNetworkCIDRConfigured(d.GetRawConfig())
+}
+
+// resourceNetworkCIDRConfigured reports whether cidr is set in the configuration.
+func resourceNetworkCIDRConfigured(config cty.Value) bool {
+	if config.IsNull() || !config.IsKnown() {
+		return false
+	}
+
+	v := config.GetAttr("cidr")
+	return !v.IsNull()
+}
+
+// resourceNetworkDisplayTextCleared reports whether display_text is explicitly
+// set to an empty string in the configuration.
+func resourceNetworkDisplayTextCleared(config cty.Value) bool {
+	if config.IsNull() || !config.IsKnown() {
+		return false
+	}
+
+	v := config.GetAttr("display_text")
+	return v.IsKnown() && !v.IsNull() && v.AsString() == ""
+}
+
+// resourceNetworkClearDisplayText clears the display text (description) of the
+// network, which the v1 API silently ignores when empty.
+func resourceNetworkClearDisplayText(ctx context.Context, meta interface{}, zone, id string) error {
+	client := GetComputeClient(meta)
+	ctx = exoapi.WithEndpoint(ctx, exoapi.NewReqEndpoint(getEnvironment(meta), zone))
+
+	description := ""
+	resp, err := client.Client.UpdatePrivateNetworkWithResponse(ctx, id, oapi.UpdatePrivateNetworkJSONRequestBody{
+		Description: &description,
+	})
+	if err != nil {
+		return err
+	}
+	if resp.JSON200 == nil {
+		return fmt.Errorf("unable to clear network display text: unexpected response status %s", resp.Status())
+	}
+
+	if _, err := oapi.NewPoller().Poll(ctx, oapi.OperationPoller(client.Client, zone, *resp.JSON200.Id)); err != nil {
+		return fmt.Errorf("unable to clear network display text: %w", err)
+	}
+
+	return nil
+}
+
+// resourceNetworkManagedSettings returns the DHCP range and netmask of the network,
+// either computed from the cidr/dhcp_range_size attributes or set explicitly.
+func resourceNetworkManagedSettings(d interface{ Get(string) interface{} }) (string, string, string, diag.Diagnostics) {
+	if cidr := d.Get("cidr").(string); cidr != "" {
+		startIP, endIP, netmask, err := networkDHCPRange(cidr, d.Get("dhcp_range_size").(int))
+		if err != nil {
+			path := cty.GetAttrPath("cidr")
+			if errors.Is(err, errNetworkDHCPRangeSize) {
+				path = cty.GetAttrPath("dhcp_range_size")
+			}
+
+			return "", "", "", diag.Diagnostics{{
+				Severity:      diag.Error,
+				Summary:       "Invalid managed private network settings",
+				Detail:        err.Error(),
+				AttributePath: path,
+			}}
+		}
+
+		return startIP.String(), endIP.String(), netmask.String(), nil
+	}
+
+	startIP := d.Get("start_ip").(string)
+	endIP := d.Get("end_ip").(string)
+	netmask := d.Get("netmask").(string)
+
+	if diags := validateNetworkManagedSettings(startIP, endIP, netmask); diags.HasError() {
+		return "", "", "", diags
+	}
+
+	return startIP, endIP, netmask, nil
+}
+
+// errNetworkDHCPRangeSize is wrapped by the networkDHCPRange errors caused by
+// the requested range size rather than by the network itself.
+var errNetworkDHCPRangeSize = errors.New("dhcp_range_size")
+
+// networkDHCPRange computes the DHCP range of size addresses starting at the first
+// usable address of the IPv4 network cidr, as well as the network mask.
+// A size of 0 spans all the usable addresses of the network.
+func networkDHCPRange(cidr string, size int) (net.IP, net.IP, net.IP, error) {
+	_, ipNet, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("invalid cidr %q: %w", cidr, err)
+	}
+
+	network := ipNet.IP.To4()
+	if network == nil {
+		return nil, nil, nil, fmt.Errorf("invalid cidr %q: only IPv4 networks are supported", cidr)
+	}
+
+	ones, bits := ipNet.Mask.Size()
+	// The network and broadcast addresses cannot be leased.
+	usable := (1 << (bits - ones)) - 2
+	if usable < 1 {
+		return nil, nil, nil, fmt.Errorf("invalid cidr %q: network too small", cidr)
+	}
+
+	if size == 0 {
+		size = usable
+	}
+	if size > usable {
+		return nil, nil, nil, fmt.Errorf(
+			"%w %d does not fit in %s (%d usable addresses)",
+			errNetworkDHCPRangeSize,
+			size,
+			cidr,
+			usable,
+		)
+	}
+
+	base := binary.BigEndian.Uint32(network)
+	startIP := make(net.IP, net.IPv4len)
+	endIP := make(net.IP, net.IPv4len)
+	binary.BigEndian.PutUint32(startIP, base+1)
+	binary.BigEndian.PutUint32(endIP, base+uint32(size))
+
+	return startIP, endIP, net.IP(ipNet.Mask), nil
+}
+
+// networkCIDR is the reverse of networkDHCPRange: it returns the IPv4 network and
+// the DHCP range size corresponding to the
+// DHCP range and netmask of a network, reporting false if they cannot be expressed
+// this way (e.g. an unmanaged network or a range not starting at the first usable
+// address of the network).
+func networkCIDR(startIP, endIP, netmask net.IP) (string, int, bool) {
+	startIP, endIP, netmask = startIP.To4(), endIP.To4(), netmask.To4()
+	if startIP == nil || endIP == nil || netmask == nil {
+		return "", 0, false
+	}
+
+	mask := net.IPMask(netmask)
+	ones, bits := mask.Size()
+	if bits == 0 {
+		// Non-canonical mask
+		return "", 0, false
+	}
+
+	ipNet := net.IPNet{IP: startIP.Mask(mask), Mask: mask}
+	base := binary.BigEndian.Uint32(ipNet.IP)
+	start := binary.BigEndian.Uint32(startIP)
+	end := binary.BigEndian.Uint32(endIP)
+	usable := (1 << (bits - ones)) - 2
+
+	if start != base+1 || end < start || !ipNet.Contains(endIP) || int(end-start) >= usable {
+		return "", 0, false
+	}
+
+	return ipNet.String(), int(end-start) + 1, true
+}
+
+// networkDHCPRangeFull reports whether a DHCP range of size addresses spans all
+// the usable addresses of the IPv4 network cidr.
+func networkDHCPRangeFull(cidr string, size int) bool {
+	_, endIP, _, err := networkDHCPRange(cidr, 0)
+	if err != nil {
+		return false
+	}
+
+	_, sizedEndIP, _, err := networkDHCPRange(cidr, size)
+	return err == nil && size > 0 && endIP.Equal(sizedEndIP)
+}
+
+// validateNetworkManagedSettings ensures that a network is either fully unmanaged
+// (none of start_ip, end_ip and netmask set) or fully managed (all of them set),
+// reporting an error diagnostic for each missing attribute.
+func validateNetworkManagedSettings(startIP, endIP, netmask string) diag.Diagnostics {
+	settings := []struct {
+		key   string
+		value string
+	}{
+		{"start_ip", startIP},
+		{"end_ip", endIP},
+		{"netmask", netmask},
+	}
+
+	set := make([]string, 0, len(settings))
+	missing := make([]string, 0, len(settings))
+	for _, setting := range settings {
+		if setting.value != "" {
+			set = append(set, setting.key)
+		} else {
+			missing = append(missing, setting.key)
+		}
+	}
+
+	if len(set) == 0 || len(missing) == 0 {
+		return nil
+	}
+
+	detail := fmt.Sprintf(
+		"managed private networks require start_ip, end_ip and netmask: %s set but %s missing (unset %s for an unmanaged network)",
+		strings.Join(set, ", "),
+		strings.Join(missing, ", "),
+		strings.Join(set, ", "),
+	)
+
+	diags := make(diag.Diagnostics, 0, len(missing))
+	for _, key := range missing {
+		diags = append(diags, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       fmt.Sprintf("Missing %s", key),
+			Detail:        detail,
+			AttributePath: cty.GetAttrPath(key),
+		})
+	}
+
+	return diags
+}
+
+// networkDiagnosticsError converts diagnostics to an error, for contexts (such as
+// CustomizeDiff) which cannot return diagnostics.
+func networkDiagnosticsError(diags diag.Diagnostics) error {
+	for _, d := range diags {
+		if d.Severity == diag.Error {
+			return errors.New(d.Detail)
+		}
+	}
+
+	return nil
 }
 
 func resourceNetworkRead(d *schema.ResourceData, meta interface{}) error {
@@ -240,52 +559,126 @@ func resourceNetworkExists(d *schema.ResourceData, meta interface{}) (bool, erro
 	return true, nil
 }
 
-func resourceNetworkUpdate(d *schema.ResourceData, meta interface{}) error {
-	tflog.Debug(context.Background(), "beginning update", map[string]interface{}{
+// resourceNetworkTagsWithRetry calls do until it succeeds, fails with a non-transient
+// error or resourceNetworkTagsMaxAttempts attempts have been made, backing off
+// exponentially between attempts: this prevents an API blip from failing (and
+// rolling back) the creation of a network.
+func resourceNetworkTagsWithRetry(ctx context.Context, do func(context.Context) error) error {
+	backoff := resourceNetworkTagsRetryBackoff
+
+	for attempt := 1; ; attempt++ {
+		err := do(ctx)
+		if err == nil || attempt == resourceNetworkTagsMaxAttempts || !resourceNetworkTagsRetryable(err) {
+			return err
+		}
+
+		tflog.Debug(ctx, "transient failure, retrying network tags request", map[string]interface{}{
+			"error":   err.Error(),
+			"attempt": attempt,
+			"backoff": backoff.String(),
+		})
+
+		select {
+		case <-time.After(backoff):
+		case <-ctx.Done():
+			return fmt.Errorf("%w (%s)", err, ctx.Err())
+		}
+
+		backoff *= 2
+	}
+}
+
+// resourceNetworkTagsRetryable reports whether the API error is transient,
+// i.e. a server-side or rate-limiting error.
+func resourceNetworkTagsRetryable(err error) bool {
+	var errResp *egoscale.ErrorResponse
+	if errors.As(err, &errResp) {
+		switch errResp.ErrorCode {
+		case egoscale.APILimitExceeded, egoscale.InternalError, egoscale.ResourceUnavailableError:
+			return true
+		}
+		return false
+	}
+
+	// Responses which are not API errors are reported as "<status code> <body>".
+	var status int
+	if _, e := fmt.Sscanf(err.Error(), "%d ", &status); e == nil {
+		return status == 429 || (status >= 500 && status <= 599)
+	}
+
+	return false
+}
+
+func resourceNetworkUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tflog.Debug(ctx, "beginning update", map[string]interface{}{
 		"id": resourceNetworkIDString(d),
 	})
 
-	ctx, cancel := context.WithTimeout(context.Background(), d.Timeout(schema.TimeoutUpdate))
+	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutUpdate))
 	defer cancel()
 
 	client := GetComputeClient(meta)
 
 	id, err := egoscale.ParseUUID(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if d.HasChange("start_ip") || d.HasChange("end_ip") {
 		for _, key := range []string{"start_ip", "end_ip"} {
 			o, n := d.GetChange(key)
 			if o.(string) != "" && n.(string) == "" {
-				return fmt.Errorf("[ERROR] new value of %q cannot be empty. old value was %s. The resource must be recreated instead", key, o.(string))
+				return diag.Diagnostics{{
+					Severity: diag.Error,
+					Summary:  fmt.Sprintf("Invalid %s", key),
+					Detail: fmt.Sprintf(
+						"new value of %q cannot be empty. old value was %s. The resource must be recreated instead",
+						key,
+						o.(string),
+					),
+					AttributePath: cty.GetAttrPath(key),
+				}}
 			}
 		}
 	}
 
+	startIP, endIP, netmask, diags := resourceNetworkManagedSettings(d)
+	if diags.HasError() {
+		return diags
+	}
+
 	// Update name and display_text
 	updateNetwork := &egoscale.UpdateNetwork{
 		ID:          id,
 		Name:        d.Get("name").(string),
 		DisplayText: d.Get("display_text").(string),
-		StartIP:     net.ParseIP(d.Get("start_ip").(string)),
-		EndIP:       net.ParseIP(d.Get("end_ip").(string)),
-		Netmask:     net.ParseIP(d.Get("netmask").(string)),
+		StartIP:     net.ParseIP(startIP),
+		EndIP:       net.ParseIP(endIP),
+		Netmask:     net.ParseIP(netmask),
 	}
 
 	// Update tags
 	requests, err := updateTags(d, "tags", egoscale.Network{}.ResourceType())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	requests = append(requests, updateNetwork)
-
 	for _, req := range requests {
-		_, err := client.RequestWithContext(ctx, req)
-		if err != nil {
+		if err := resourceNetworkTagsWithRetry(ctx, func(ctx context.Context) error {
+			_, err := client.RequestWithContext(ctx, req)
 			return err
+		}); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if _, err := client.RequestWithContext(ctx, updateNetwork); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("display_text") && d.Get("display_text").(string) == "" {
+		if err := resourceNetworkClearDisplayText(ctx, meta, d.Get("zone").(string), d.Id()); err != nil {
+			return diag.FromErr(err)
 		}
 	}
 
@@ -293,7 +686,7 @@ func resourceNetworkUpdate(d *schema.ResourceData, meta interface{}) error {
 		"id": resourceNetworkIDString(d),
 	})
 
-	return resourceNetworkRead(d, meta)
+	return diag.FromErr(resourceNetworkRead(d, meta))
 }
 
 func resourceNetworkDelete(d *schema.ResourceData, meta interface{}) error {
@@ -317,6 +710,21 @@ func resourceNetworkDelete(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
+	if d.Get(utils.AttrWaitForDeletion).(bool) {
+		zone := d.Get("zone").(string)
+		ctx := exoapi.WithEndpoint(ctx, exoapi.NewReqEndpoint(getEnvironment(meta), zone))
+
+		if err := utils.WaitForDeletion(ctx, func(ctx context.Context) (bool, error) {
+			_, err := client.GetPrivateNetwork(ctx, zone, d.Id())
+			if errors.Is(err, exoapi.ErrNotFound) {
+				return false, nil
+			}
+			return err == nil, err
+		}); err != nil {
+			return err
+		}
+	}
+
 	tflog.Debug(ctx, "delete finished successfully", map[string]interface{}{
 		"id": resourceNetworkIDString(d),
 	})
@@ -324,6 +732,28 @@ func resourceNetworkDelete(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
+// resourceNetworkImport accepts both the "<ID>" and the "<ID>@<ZONE>" (as used by
+// exoscale_private_network) formats, the zone being looked up on read anyway.
+func resourceNetworkImport(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	id, zone, found := strings.Cut(d.Id(), "@")
+	if found {
+		d.SetId(id)
+		if err := d.Set("zone", zone); err != nil {
+			return nil, err
+		}
+	}
+
+	if _, err := egoscale.ParseUUID(d.Id()); err != nil {
+		return nil, fmt.Errorf(`invalid ID %q, expected format "<ID>" or "<ID>@<ZONE>"`, d.Id())
+	}
+
+	if err := d.Set(utils.AttrWaitForDeletion, false); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceNetworkApply(d *schema.ResourceData, network *egoscale.Network) error {
 	d.SetId(network.ID.String())
 	if err := d.Set("name", network.Name); err != nil {
@@ -354,6 +784,25 @@ func resourceNetworkApply(d *schema.ResourceData, network *egoscale.Network) err
 		d.Set("netmask", "")  // nolint: errcheck
 	}
 
+	// Networks managed through cidr get it (and dhcp_range_size) refreshed from
+	// the actual DHCP range, so that any drift shows up in the plan: a range which
+	// cannot be expressed with these attributes is reported as an empty cidr.
+	// Networks are only managed through cidr if configured so, as it is not
+	// inferred on import.
+	if d.Get("cidr").(string) != "" {
+		cidr, size, _ := networkCIDR(network.StartIP, network.EndIP, network.Netmask)
+		if d.Get("dhcp_range_size").(int) == 0 && networkDHCPRangeFull(cidr, size) {
+			// Unset dhcp_range_size, defaulting to all the usable addresses
+			size = 0
+		}
+		if err := d.Set("cidr", cidr); err != nil {
+			return err
+		}
+		if err := d.Set("dhcp_range_size", size); err != nil {
+			return err
+		}
+	}
+
 	// tags
 	tags := make(map[string]interface{})
 	for _, tag := range network.Tags {
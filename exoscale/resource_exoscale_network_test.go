@@ -1,13 +1,21 @@
 package exoscale
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"net"
 	"testing"
+	"time"
 
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/require"
 
 	"github.com/exoscale/egoscale"
 )
@@ -117,6 +125,15 @@ func TestAccResourceNetwork(t *testing.T) {
 						s[0].Attributes)
 				},
 			},
+			{
+				// The exoscale_private_network import ID format is accepted as well.
+				ResourceName: "exoscale_network.net",
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					return fmt.Sprintf("%s@%s", network.ID, testAccResourceNetworkZoneName), nil
+				},
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -198,3 +215,395 @@ func testAccCheckResourceNetworkDestroy(s *terraform.State) error {
 
 	return errors.New("Network still exists")
 }
+
+func Test_validateNetworkManagedSettings(t *testing.T) {
+	tests := []struct {
+		name      string
+		startIP   string
+		endIP     string
+		netmask   string
+		wantErr   string
+		wantPaths []cty.Path
+	}{
+		{name: "unmanaged"},
+		{name: "managed", startIP: "10.0.0.10", endIP: "10.0.0.50", netmask: "255.255.255.0"},
+		{
+			name:      "missing netmask",
+			startIP:   "10.0.0.10",
+			endIP:     "10.0.0.50",
+			wantErr:   "start_ip, end_ip set but netmask missing",
+			wantPaths: []cty.Path{cty.GetAttrPath("netmask")},
+		},
+		{
+			name:      "missing end_ip",
+			startIP:   "10.0.0.10",
+			netmask:   "255.255.255.0",
+			wantErr:   "start_ip, netmask set but end_ip missing",
+			wantPaths: []cty.Path{cty.GetAttrPath("end_ip")},
+		},
+		{
+			name:      "netmask only",
+			netmask:   "255.255.255.0",
+			wantErr:   "netmask set but start_ip, end_ip missing",
+			wantPaths: []cty.Path{cty.GetAttrPath("start_ip"), cty.GetAttrPath("end_ip")},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diags := validateNetworkManagedSettings(tt.startIP, tt.endIP, tt.netmask)
+			if tt.wantErr == "" {
+				require.False(t, diags.HasError())
+				return
+			}
+			require.Len(t, diags, len(tt.wantPaths))
+			for i, d := range diags {
+				require.Equal(t, diag.Error, d.Severity)
+				require.Contains(t, d.Detail, tt.wantErr)
+				require.True(t, tt.wantPaths[i].Equals(d.AttributePath))
+			}
+		})
+	}
+}
+
+func Test_resourceNetworkManagedSettings(t *testing.T) {
+	tests := []struct {
+		name     string
+		config   map[string]interface{}
+		wantErr  string
+		wantPath cty.Path
+	}{
+		{
+			name:     "invalid cidr",
+			config:   map[string]interface{}{"cidr": "10.0.0.0/31"},
+			wantErr:  "network too small",
+			wantPath: cty.GetAttrPath("cidr"),
+		},
+		{
+			name:     "range too large",
+			config:   map[string]interface{}{"cidr": "10.0.0.0/28", "dhcp_range_size": 15},
+			wantErr:  "dhcp_range_size 15 does not fit in 10.0.0.0/28",
+			wantPath: cty.GetAttrPath("dhcp_range_size"),
+		},
+		{
+			name:     "incomplete range",
+			config:   map[string]interface{}{"start_ip": "10.0.0.10", "end_ip": "10.0.0.50"},
+			wantErr:  "start_ip, end_ip set but netmask missing",
+			wantPath: cty.GetAttrPath("netmask"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, resourceNetwork().Schema, tt.config)
+
+			_, _, _, diags := resourceNetworkManagedSettings(d)
+			require.Len(t, diags, 1)
+			require.Contains(t, diags[0].Detail, tt.wantErr)
+			require.True(t, tt.wantPath.Equals(diags[0].AttributePath))
+		})
+	}
+}
+
+func TestAccResourceNetwork_CIDR(t *testing.T) {
+	network := new(egoscale.Network)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckResourceNetworkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "exoscale_network" "net" {
+  zone = "%s"
+  name = "%s"
+
+  cidr            = "10.0.0.0/24"
+  dhcp_range_size = 100
+
+  wait_for_deletion = true
+}
+`,
+					testAccResourceNetworkZoneName,
+					testAccResourceNetworkName,
+				),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceNetworkExists("exoscale_network.net", network),
+					testAccCheckResourceNetworkAttributes(testAttrs{
+						"start_ip": validateString("10.0.0.1"),
+						"end_ip":   validateString("10.0.0.100"),
+						"netmask":  validateString("255.255.255.0"),
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNetwork_DisplayText(t *testing.T) {
+	network := new(egoscale.Network)
+
+	config := func(displayText string) string {
+		return fmt.Sprintf(`
+resource "exoscale_network" "net" {
+  zone         = "%s"
+  name         = "%s"
+  display_text = "%s"
+}
+`,
+			testAccResourceNetworkZoneName,
+			testAccResourceNetworkName,
+			displayText,
+		)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckResourceNetworkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config(testAccResourceNetworkDisplayText),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceNetworkExists("exoscale_network.net", network),
+					testAccCheckResourceNetworkAttributes(testAttrs{
+						"display_text": validateString(testAccResourceNetworkDisplayText),
+					}),
+				),
+			},
+			{
+				Config: config(testAccResourceNetworkDisplayText + "-updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceNetworkExists("exoscale_network.net", network),
+					testAccCheckResourceNetworkAttributes(testAttrs{
+						"display_text": validateString(testAccResourceNetworkDisplayText + "-updated"),
+					}),
+				),
+			},
+			{
+				Config: config(""),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("exoscale_network.net", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceNetworkExists("exoscale_network.net", network),
+					testAccCheckResourceNetworkAttributes(testAttrs{
+						"display_text": validateString(""),
+					}),
+				),
+			},
+		},
+	})
+}
+
+func Test_networkDHCPRange(t *testing.T) {
+	tests := []struct {
+		name        string
+		cidr        string
+		size        int
+		wantStartIP string
+		wantEndIP   string
+		wantNetmask string
+		wantErr     string
+	}{
+		{
+			name:        "full range",
+			cidr:        "10.0.0.0/24",
+			wantStartIP: "10.0.0.1",
+			wantEndIP:   "10.0.0.254",
+			wantNetmask: "255.255.255.0",
+		},
+		{
+			name:        "sized range",
+			cidr:        "172.16.0.0/16",
+			size:        1000,
+			wantStartIP: "172.16.0.1",
+			wantEndIP:   "172.16.3.232",
+			wantNetmask: "255.255.0.0",
+		},
+		{
+			name:        "non-canonical network address",
+			cidr:        "192.168.1.42/30",
+			wantStartIP: "192.168.1.41",
+			wantEndIP:   "192.168.1.42",
+			wantNetmask: "255.255.255.252",
+		},
+		{
+			name:    "range too large",
+			cidr:    "10.0.0.0/28",
+			size:    15,
+			wantErr: "dhcp_range_size 15 does not fit in 10.0.0.0/28 (14 usable addresses)",
+		},
+		{
+			name:    "network too small",
+			cidr:    "10.0.0.0/31",
+			wantErr: "network too small",
+		},
+		{
+			name:    "IPv6",
+			cidr:    "2001:db8::/64",
+			wantErr: "only IPv4 networks are supported",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			startIP, endIP, netmask, err := networkDHCPRange(tt.cidr, tt.size)
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.wantStartIP, startIP.String())
+			require.Equal(t, tt.wantEndIP, endIP.String())
+			require.Equal(t, tt.wantNetmask, netmask.String())
+		})
+	}
+}
+
+func Test_networkCIDR(t *testing.T) {
+	tests := []struct {
+		name     string
+		startIP  string
+		endIP    string
+		netmask  string
+		wantCIDR string
+		wantSize int
+		wantOK   bool
+	}{
+		{
+			name:     "full range",
+			startIP:  "10.0.0.1",
+			endIP:    "10.0.0.254",
+			netmask:  "255.255.255.0",
+			wantCIDR: "10.0.0.0/24",
+			wantSize: 254,
+			wantOK:   true,
+		},
+		{
+			name:     "sized range",
+			startIP:  "172.16.0.1",
+			endIP:    "172.16.3.232",
+			netmask:  "255.255.0.0",
+			wantCIDR: "172.16.0.0/16",
+			wantSize: 1000,
+			wantOK:   true,
+		},
+		{
+			name:    "range not starting at the first usable address",
+			startIP: "10.0.0.10",
+			endIP:   "10.0.0.20",
+			netmask: "255.255.255.0",
+		},
+		{
+			name:    "range including the broadcast address",
+			startIP: "10.0.0.1",
+			endIP:   "10.0.0.255",
+			netmask: "255.255.255.0",
+		},
+		{
+			name:    "range outside of the network",
+			startIP: "10.0.0.1",
+			endIP:   "10.0.1.10",
+			netmask: "255.255.255.0",
+		},
+		{
+			name:    "non-canonical netmask",
+			startIP: "10.0.0.1",
+			endIP:   "10.0.0.10",
+			netmask: "255.0.255.0",
+		},
+		{
+			name: "unmanaged network",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cidr, size, ok := networkCIDR(net.ParseIP(tt.startIP), net.ParseIP(tt.endIP), net.ParseIP(tt.netmask))
+			require.Equal(t, tt.wantOK, ok)
+			require.Equal(t, tt.wantCIDR, cidr)
+			require.Equal(t, tt.wantSize, size)
+
+			if ok {
+				startIP, endIP, netmask, err := networkDHCPRange(cidr, size)
+				require.NoError(t, err)
+				require.Equal(t, tt.startIP, startIP.String())
+				require.Equal(t, tt.endIP, endIP.String())
+				require.Equal(t, tt.netmask, netmask.String())
+			}
+		})
+	}
+}
+
+func Test_networkDHCPRangeFull(t *testing.T) {
+	// Full range round trip, with an explicit or default dhcp_range_size
+	for _, size := range []int{0, 254} {
+		startIP, endIP, netmask, err := networkDHCPRange("10.0.0.0/24", size)
+		require.NoError(t, err)
+
+		cidr, gotSize, ok := networkCIDR(startIP, endIP, netmask)
+		require.True(t, ok)
+		require.Equal(t, "10.0.0.0/24", cidr)
+		require.Equal(t, 254, gotSize)
+		require.True(t, networkDHCPRangeFull(cidr, gotSize))
+	}
+
+	require.False(t, networkDHCPRangeFull("10.0.0.0/24", 100))
+	require.False(t, networkDHCPRangeFull("", 0))
+}
+
+func Test_resourceNetworkTagsWithRetry(t *testing.T) {
+	defer func(backoff time.Duration) { resourceNetworkTagsRetryBackoff = backoff }(resourceNetworkTagsRetryBackoff)
+	resourceNetworkTagsRetryBackoff = time.Millisecond
+
+	transient := &egoscale.ErrorResponse{ErrorCode: egoscale.InternalError, ErrorText: "internal error"}
+
+	t.Run("recovers from a transient failure", func(t *testing.T) {
+		calls := 0
+		err := resourceNetworkTagsWithRetry(context.Background(), func(context.Context) error {
+			if calls++; calls == 1 {
+				return transient
+			}
+			return nil
+		})
+		require.NoError(t, err)
+		require.Equal(t, 2, calls)
+	})
+
+	t.Run("rate limited", func(t *testing.T) {
+		calls := 0
+		err := resourceNetworkTagsWithRetry(context.Background(), func(context.Context) error {
+			if calls++; calls == 1 {
+				return errors.New("429 Too Many Requests")
+			}
+			return nil
+		})
+		require.NoError(t, err)
+		require.Equal(t, 2, calls)
+	})
+
+	t.Run("non-transient error", func(t *testing.T) {
+		calls := 0
+		paramErr := &egoscale.ErrorResponse{ErrorCode: egoscale.ParamError, ErrorText: "invalid tag"}
+		err := resourceNetworkTagsWithRetry(context.Background(), func(context.Context) error {
+			calls++
+			return paramErr
+		})
+		require.ErrorIs(t, err, paramErr)
+		require.Equal(t, 1, calls)
+	})
+
+	t.Run("bounded attempts", func(t *testing.T) {
+		calls := 0
+		err := resourceNetworkTagsWithRetry(context.Background(), func(context.Context) error {
+			calls++
+			return transient
+		})
+		require.ErrorIs(t, err, transient)
+		require.Equal(t, resourceNetworkTagsMaxAttempts, calls)
+	})
+}
@@ -2,8 +2,11 @@ package exoscale
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net"
+	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -14,6 +17,13 @@ import (
 	"github.com/exoscale/terraform-provider-exoscale/pkg/general"
 )
 
+// resourceNICCreateRetryBackoff is the initial delay between NIC creation attempts
+// failing because the managed private network configuration has not settled yet.
+var resourceNICCreateRetryBackoff = 2 * time.Second
+
+// resourceNICCreateRetryMaxBackoff is the maximum delay between NIC creation attempts.
+var resourceNICCreateRetryMaxBackoff = 30 * time.Second
+
 func resourceNICIDString(d general.ResourceIDStringer) string {
 	return general.ResourceIDString(d, "exoscale_nic")
 }
@@ -96,10 +106,14 @@ func resourceNICCreate(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
-	resp, err := client.RequestWithContext(ctx, &egoscale.AddNicToVirtualMachine{
-		NetworkID:        networkID,
-		VirtualMachineID: vmID,
-		IPAddress:        ip,
+	// Attaching a NIC right after the private network has been created or updated
+	// may fail until the network managed configuration (DHCP range) has settled.
+	resp, err := resourceNICCreateWithRetry(ctx, func(ctx context.Context) (interface{}, error) {
+		return client.RequestWithContext(ctx, &egoscale.AddNicToVirtualMachine{
+			NetworkID:        networkID,
+			VirtualMachineID: vmID,
+			IPAddress:        ip,
+		})
 	})
 	if err != nil {
 		return err
@@ -120,6 +134,52 @@ func resourceNICCreate(d *schema.ResourceData, meta interface{}) error {
 	return resourceNICRead(d, meta)
 }
 
+// resourceNICCreateWithRetry calls create until it succeeds or fails with an error
+// other than the one reported while the private network managed configuration is
+// being applied, backing off exponentially between attempts until the context is done.
+func resourceNICCreateWithRetry(
+	ctx context.Context,
+	create func(context.Context) (interface{}, error),
+) (interface{}, error) {
+	backoff := resourceNICCreateRetryBackoff
+
+	for {
+		resp, err := create(ctx)
+		if err == nil || !resourceNICCreateRetryable(err) {
+			return resp, err
+		}
+
+		tflog.Debug(ctx, "private network not ready, retrying NIC creation", map[string]interface{}{
+			"error":   err.Error(),
+			"backoff": backoff.String(),
+		})
+
+		select {
+		case <-time.After(backoff):
+		case <-ctx.Done():
+			return nil, fmt.Errorf("%w (%s)", err, ctx.Err())
+		}
+
+		if backoff *= 2; backoff > resourceNICCreateRetryMaxBackoff {
+			backoff = resourceNICCreateRetryMaxBackoff
+		}
+	}
+}
+
+// resourceNICCreateRetryable reports whether the NIC creation error is caused by
+// the private network managed configuration not being applied yet. Any other
+// error, such as an invalid static IP address, is returned right away.
+func resourceNICCreateRetryable(err error) bool {
+	msg := err.Error()
+
+	var errResp *egoscale.ErrorResponse
+	if errors.As(err, &errResp) {
+		msg = errResp.ErrorText
+	}
+
+	return strings.Contains(strings.ToLower(msg), "not ready")
+}
+
 func resourceNICRead(d *schema.ResourceData, meta interface{}) error {
 	tflog.Debug(context.Background(), "beginning read", map[string]interface{}{
 		"id": resourceNICIDString(d),
@@ -1,15 +1,18 @@
 package exoscale
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/require"
 
 	"github.com/exoscale/egoscale"
 )
@@ -152,6 +155,83 @@ func TestAccResourceNIC(t *testing.T) {
 	})
 }
 
+func Test_resourceNICCreateRetryable(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{
+			name: "network not ready",
+			err:  &egoscale.ErrorResponse{ErrorCode: egoscale.ParamError, ErrorText: "Network is not ready yet"},
+			want: true,
+		},
+		{
+			name: "wrapped",
+			err:  fmt.Errorf("request failed: %w", &egoscale.ErrorResponse{ErrorText: "Network Is Not Ready"}),
+			want: true,
+		},
+		{
+			name: "IP address out of range",
+			err:  &egoscale.ErrorResponse{ErrorCode: egoscale.ParamError, ErrorText: "IP address is out of range"},
+		},
+		{
+			name: "other API error",
+			err:  &egoscale.ErrorResponse{ErrorCode: egoscale.ParamError, ErrorText: "Virtual machine not found"},
+		},
+		{
+			name: "other error",
+			err:  errors.New("connection refused"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, resourceNICCreateRetryable(tt.err))
+		})
+	}
+}
+
+func Test_resourceNICCreateWithRetry(t *testing.T) {
+	defer func(backoff time.Duration) { resourceNICCreateRetryBackoff = backoff }(resourceNICCreateRetryBackoff)
+	resourceNICCreateRetryBackoff = time.Millisecond
+
+	notReady := &egoscale.ErrorResponse{ErrorCode: egoscale.ParamError, ErrorText: "Network is not ready yet"}
+
+	t.Run("recovers", func(t *testing.T) {
+		calls := 0
+		resp, err := resourceNICCreateWithRetry(context.Background(), func(context.Context) (interface{}, error) {
+			if calls++; calls < 3 {
+				return nil, notReady
+			}
+			return "ok", nil
+		})
+		require.NoError(t, err)
+		require.Equal(t, "ok", resp)
+		require.Equal(t, 3, calls)
+	})
+
+	t.Run("non-retryable error", func(t *testing.T) {
+		calls := 0
+		_, err := resourceNICCreateWithRetry(context.Background(), func(context.Context) (interface{}, error) {
+			calls++
+			return nil, errors.New("boom")
+		})
+		require.EqualError(t, err, "boom")
+		require.Equal(t, 1, calls)
+	})
+
+	t.Run("bounded by context", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+
+		_, err := resourceNICCreateWithRetry(ctx, func(context.Context) (interface{}, error) {
+			return nil, notReady
+		})
+		require.ErrorIs(t, err, notReady)
+	})
+}
+
 func testAccCheckResourceNICExists(n string, vm *egoscale.VirtualMachine, nic *egoscale.Nic) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -11,6 +11,7 @@ import (
 	exoapi "github.com/exoscale/egoscale/v2/api"
 	"github.com/exoscale/terraform-provider-exoscale/pkg/config"
 	"github.com/exoscale/terraform-provider-exoscale/pkg/general"
+	"github.com/exoscale/terraform-provider-exoscale/pkg/utils"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -71,10 +72,11 @@ func resourceNLB() *schema.Resource {
 			Description: "The current NLB state.",
 		},
 		resNLBAttrZone: {
-			Type:        schema.TypeString,
-			Required:    true,
-			ForceNew:    true,
-			Description: "The Exoscale [Zone](https://www.exoscale.com/datacenters/) name.",
+			Type:             schema.TypeString,
+			Required:         true,
+			ForceNew:         true,
+			Description:      "The Exoscale [Zone](https://www.exoscale.com/datacenters/) name.",
+			ValidateDiagFunc: utils.ValidateZone(),
 		},
 	}
 
@@ -10,11 +10,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	egoscale "github.com/exoscale/egoscale/v2"
 	exoapi "github.com/exoscale/egoscale/v2/api"
+	"github.com/exoscale/egoscale/v2/oapi"
 	"github.com/exoscale/terraform-provider-exoscale/pkg/config"
 	"github.com/exoscale/terraform-provider-exoscale/pkg/general"
+	"github.com/exoscale/terraform-provider-exoscale/pkg/utils"
 )
 
 const (
@@ -57,9 +60,11 @@ func resourceNLBService() *schema.Resource {
 			Description: "A free-form text describing the NLB service.",
 		},
 		resNLBServiceAttrHealthcheck: {
-			Description: "The service health checking configuration (may only bet set at creation time).",
-			Type:        schema.TypeSet,
-			Required:    true,
+			Description: "The service health checking configuration (may only bet set at creation time). " +
+				"If not set, the provider `nlb_service_healthcheck_defaults` apply, the healthcheck `port` being the service `target_port`.",
+			Type:     schema.TypeSet,
+			Optional: true,
+			Computed: true,
 			Elem: &schema.Resource{
 				Schema: map[string]*schema.Schema{
 					resNLBServiceAttrHealthcheckInterval: {
@@ -69,9 +74,14 @@ func resourceNLBService() *schema.Resource {
 						Description: "The healthcheck interval in seconds (default: `10`).",
 					},
 					resNLBServiceAttrHealthcheckMode: {
-						Type:        schema.TypeString,
-						Optional:    true,
-						Default:     defaultNLBServiceHealthcheckMode,
+						Type:     schema.TypeString,
+						Optional: true,
+						Default:  defaultNLBServiceHealthcheckMode,
+						ValidateFunc: validation.StringInSlice([]string{
+							string(oapi.LoadBalancerServiceHealthcheckModeTcp),
+							string(oapi.LoadBalancerServiceHealthcheckModeHttp),
+							string(oapi.LoadBalancerServiceHealthcheckModeHttps),
+						}, false),
 						Description: "The healthcheck mode (`tcp`|`http`|`https`; default: `tcp`).",
 					},
 					resNLBServiceAttrHealthcheckPort: {
@@ -126,20 +136,30 @@ func resourceNLBService() *schema.Resource {
 			Description: "The healthcheck port.",
 		},
 		resNLBServiceAttrProtocol: {
-			Type:        schema.TypeString,
-			Optional:    true,
-			Default:     defaultNLBServiceProtocol,
-			Description: "The protocol (`tcp`|`udp`; default: `tcp`).",
+			Type:     schema.TypeString,
+			Optional: true,
+			Default:  defaultNLBServiceProtocol,
+			ValidateFunc: validation.StringInSlice([]string{
+				string(oapi.LoadBalancerServiceProtocolTcp),
+				string(oapi.LoadBalancerServiceProtocolUdp),
+			}, false),
+			Description: "The protocol (`tcp`|`udp`; default: `tcp`). The NLB forwards traffic as is: TLS termination is not supported.",
 		},
 		resNLBServiceAttrState: {
 			Type:     schema.TypeString,
 			Computed: true,
 		},
 		resNLBServiceAttrStrategy: {
-			Type:        schema.TypeString,
-			Optional:    true,
-			Default:     defaulNLBServiceStrategy,
-			Description: "The strategy (`round-robin`|`source-hash`; default: `round-robin`).",
+			Type:     schema.TypeString,
+			Optional: true,
+			Default:  defaulNLBServiceStrategy,
+			ValidateFunc: validation.StringInSlice([]string{
+				string(oapi.LoadBalancerServiceStrategyRoundRobin),
+				string(oapi.LoadBalancerServiceStrategySourceHash),
+			}, false),
+			Description: "The strategy (`round-robin`|`source-hash`; default: `round-robin`). " +
+				"With `source-hash`, the target instance is selected from a hash of the client source IP address, " +
+				"so that a client keeps reaching the same instance as long as the pool members don't change.",
 		},
 		resNLBServiceAttrTargetPort: {
 			Type:        schema.TypeInt,
@@ -147,10 +167,11 @@ func resourceNLBService() *schema.Resource {
 			Description: "The (TCP/UDP) port to forward traffic to (on target instance pool members).",
 		},
 		resNLBServiceAttrZone: {
-			Type:        schema.TypeString,
-			Required:    true,
-			ForceNew:    true,
-			Description: "The Exoscale [Zone](https://www.exoscale.com/datacenters/) name.",
+			Type:             schema.TypeString,
+			Required:         true,
+			ForceNew:         true,
+			Description:      "The Exoscale [Zone](https://www.exoscale.com/datacenters/) name.",
+			ValidateDiagFunc: utils.ValidateZone(),
 		},
 	}
 
@@ -164,26 +185,10 @@ func resourceNLBService() *schema.Resource {
 		UpdateContext: resourceNLBServiceUpdate,
 		DeleteContext: resourceNLBServiceDelete,
 
+		CustomizeDiff: resourceNLBServiceCustomizeDiff,
+
 		Importer: &schema.ResourceImporter{
-			StateContext: func(ctx context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
-				zonedRes, err := zonedStateContextFunc(ctx, d, nil)
-				if err != nil {
-					return nil, err
-				}
-				d = zonedRes[0]
-
-				parts := strings.SplitN(d.Id(), "/", 2)
-				if len(parts) != 2 {
-					return nil, fmt.Errorf(`invalid ID %q, expected format "<NLB-ID>/<SERVICE-ID>@<ZONE>"`, d.Id())
-				}
-
-				d.SetId(parts[1])
-				if err := d.Set(resNLBServiceAttrNLBID, parts[0]); err != nil {
-					return nil, err
-				}
-
-				return []*schema.ResourceData{d}, nil
-			},
+			StateContext: resourceNLBServiceImport,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -195,6 +200,174 @@ func resourceNLBService() *schema.Resource {
 	}
 }
 
+func resourceNLBServiceCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	// Services not declaring a healthcheck block inherit the default settings,
+	// surfaced in the plan as the effective configuration.
+	if hc := d.GetRawConfig().GetAttr(resNLBServiceAttrHealthcheck); hc.IsKnown() && (hc.IsNull() || hc.LengthInt() == 0) {
+		if !d.NewValueKnown(resNLBServiceAttrTargetPort) {
+			return d.SetNewComputed(resNLBServiceAttrHealthcheck)
+		}
+
+		healthcheck := nlbServiceDefaultHealthcheck(
+			getNLBServiceHealthcheckDefaults(meta),
+			d.Get(resNLBServiceAttrTargetPort).(int),
+		)
+		if err := d.SetNew(resNLBServiceAttrHealthcheck, []interface{}{healthcheck}); err != nil {
+			return err
+		}
+	}
+
+	// Values depending on other resources are only known at apply time.
+	if !d.NewValueKnown(resNLBServiceAttrHealthcheck) {
+		return nil
+	}
+
+	healthchecks := d.Get(resNLBServiceAttrHealthcheck).(*schema.Set).List()
+	if len(healthchecks) == 0 {
+		return nil
+	}
+
+	return validateNLBServiceHealthcheck(healthchecks[0].(map[string]interface{}))
+}
+
+// validateNLBServiceHealthcheck ensures that the healthcheck settings are consistent
+// with the healthcheck mode, since the API otherwise only rejects them at apply time.
+func validateNLBServiceHealthcheck(healthcheck map[string]interface{}) error {
+	mode, _ := healthcheck[resNLBServiceAttrHealthcheckMode].(string)
+	tlsSNI, _ := healthcheck[resNLBServiceAttrHealthcheckTLSSNI].(string)
+	uri, _ := healthcheck[resNLBServiceAttrHealthcheckURI].(string)
+
+	if tlsSNI != "" && mode != string(oapi.LoadBalancerServiceHealthcheckModeHttps) {
+		return fmt.Errorf("healthcheck %s can only be set with the https mode (got %q)", resNLBServiceAttrHealthcheckTLSSNI, mode)
+	}
+
+	if uri != "" && !strings.HasPrefix(mode, "http") {
+		return fmt.Errorf("healthcheck %s can only be set with the http and https modes (got %q)", resNLBServiceAttrHealthcheckURI, mode)
+	}
+
+	return nil
+}
+
+// nlbServiceHealthcheckDefaultsSchema returns the schema of the provider-level
+// nlb_service_healthcheck_defaults block.
+func nlbServiceHealthcheckDefaultsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Description: "Default healthcheck settings of the `exoscale_nlb_service` resources not declaring a `healthcheck` block " +
+			"(the healthcheck port being the service target port).",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				resNLBServiceAttrHealthcheckInterval: {
+					Type:        schema.TypeInt,
+					Optional:    true,
+					Description: "The healthcheck interval in seconds (default: `10`).",
+				},
+				resNLBServiceAttrHealthcheckMode: {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "The healthcheck mode (`tcp`|`http`|`https`; default: `tcp`).",
+				},
+				resNLBServiceAttrHealthcheckRetries: {
+					Type:        schema.TypeInt,
+					Optional:    true,
+					Description: "The healthcheck retries (default: `1`).",
+				},
+				resNLBServiceAttrHealthcheckTimeout: {
+					Type:        schema.TypeInt,
+					Optional:    true,
+					Description: "The healthcheck timeout (seconds; default: `5`).",
+				},
+				resNLBServiceAttrHealthcheckTLSSNI: {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "The healthcheck TLS SNI server name (only if `mode` is `https`).",
+				},
+				resNLBServiceAttrHealthcheckURI: {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "The healthcheck URI (must be set only if `mode` is `http(s)`).",
+				},
+			},
+		},
+	}
+}
+
+// getNLBServiceHealthcheckDefaults returns the provider-level NLB service healthcheck
+// defaults (nil if not configured).
+func getNLBServiceHealthcheckDefaults(meta interface{}) map[string]interface{} {
+	if defaults, ok := meta.(map[string]interface{})["nlb_service_healthcheck_defaults"]; ok {
+		return defaults.(map[string]interface{})
+	}
+	return nil
+}
+
+// nlbServiceDefaultHealthcheck returns the healthcheck settings of an NLB service not
+// declaring a healthcheck block: the built-in defaults, overridden by the non-empty
+// provider-level ones, checking the specified port.
+func nlbServiceDefaultHealthcheck(defaults map[string]interface{}, port int) map[string]interface{} {
+	healthcheck := map[string]interface{}{
+		resNLBServiceAttrHealthcheckInterval: defaultNLBServiceHealthcheckInterval,
+		resNLBServiceAttrHealthcheckMode:     defaultNLBServiceHealthcheckMode,
+		resNLBServiceAttrHealthcheckPort:     port,
+		resNLBServiceAttrHealthcheckRetries:  defaultNLBServiceHealthcheckRetries,
+		resNLBServiceAttrHealthcheckTimeout:  defaultNLBServiceHealthcheckTimeout,
+		resNLBServiceAttrHealthcheckTLSSNI:   "",
+		resNLBServiceAttrHealthcheckURI:      "",
+	}
+
+	for k, v := range defaults {
+		if k == resNLBServiceAttrHealthcheckPort {
+			continue
+		}
+
+		switch v := v.(type) {
+		case int:
+			if v != 0 {
+				healthcheck[k] = v
+			}
+		case string:
+			if v != "" {
+				healthcheck[k] = v
+			}
+		}
+	}
+
+	return healthcheck
+}
+
+// resourceNLBServiceImport imports an existing NLB service, expecting an
+// import ID in the format "<NLB-ID>/<SERVICE-ID>@<ZONE>". The remaining
+// attributes (including the healthcheck block) are resolved by the subsequent
+// read.
+func resourceNLBServiceImport(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	invalidIDErr := fmt.Errorf(`invalid ID %q, expected format "<NLB-ID>/<SERVICE-ID>@<ZONE>"`, d.Id())
+
+	parts := strings.SplitN(d.Id(), "@", 2)
+	if len(parts) != 2 || parts[1] == "" {
+		return nil, invalidIDErr
+	}
+	zone := parts[1]
+
+	parts = strings.SplitN(parts[0], "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, invalidIDErr
+	}
+
+	d.SetId(parts[1])
+
+	if err := d.Set(resNLBServiceAttrNLBID, parts[0]); err != nil {
+		return nil, err
+	}
+
+	if err := d.Set(resNLBServiceAttrZone, zone); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceNLBServiceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	tflog.Debug(ctx, "beginning create", map[string]interface{}{
 		"id": resourceNLBServiceIDString(d),
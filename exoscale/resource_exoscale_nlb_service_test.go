@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/stretchr/testify/require"
 
@@ -254,8 +255,13 @@ func TestAccResourceNLBService(t *testing.T) {
 				),
 			},
 			{
-				// Update
+				// Update (strategy switched from round-robin to source-hash in place)
 				Config: testAccResourceNLBServiceConfigUpdate,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(r, plancheck.ResourceActionUpdate),
+					},
+				},
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckResourceNLBServiceExists(r, &nlbService),
 					func(s *terraform.State) error {
@@ -325,13 +331,15 @@ func TestAccResourceNLBService(t *testing.T) {
 							resNLBServiceAttrHealthcheck + ".0." + resNLBServiceAttrHealthcheckTimeout:  validateString(testAccResourceNLBServiceHealthcheckTimeoutUpdated),
 							resNLBServiceAttrHealthcheck + ".0." + resNLBServiceAttrHealthcheckTLSSNI:   validateString(testAccResourceNLBServiceHealthcheckTLSSNI),
 							resNLBServiceAttrHealthcheck + ".0." + resNLBServiceAttrHealthcheckURI:      validateString(testAccResourceNLBServiceHealthcheckURI),
-							resNLBServiceAttrNLBID:      validation.ToDiagFunc(validation.IsUUID),
-							resNLBServiceAttrName:       validateString(testAccResourceNLBServiceNameUpdated),
-							resNLBServiceAttrPort:       validateString(testAccResourceNLBServicePortUpdated),
-							resNLBServiceAttrProtocol:   validateString(testAccResourceNLBServiceProtocolUpdated),
-							resNLBServiceAttrState:      validation.ToDiagFunc(validation.NoZeroValues),
-							resNLBServiceAttrStrategy:   validateString(testAccResourceNLBServiceStrategyUpdated),
-							resNLBServiceAttrTargetPort: validateString(testAccResourceNLBServiceTargetPortUpdated),
+							resNLBServiceAttrInstancePoolID:                                             validation.ToDiagFunc(validation.IsUUID),
+							resNLBServiceAttrNLBID:                                                      validation.ToDiagFunc(validation.IsUUID),
+							resNLBServiceAttrName:                                                       validateString(testAccResourceNLBServiceNameUpdated),
+							resNLBServiceAttrPort:                                                       validateString(testAccResourceNLBServicePortUpdated),
+							resNLBServiceAttrProtocol:                                                   validateString(testAccResourceNLBServiceProtocolUpdated),
+							resNLBServiceAttrState:                                                      validation.ToDiagFunc(validation.NoZeroValues),
+							resNLBServiceAttrStrategy:                                                   validateString(testAccResourceNLBServiceStrategyUpdated),
+							resNLBServiceAttrTargetPort:                                                 validateString(testAccResourceNLBServiceTargetPortUpdated),
+							resNLBServiceAttrZone:                                                       validateString(testZoneName),
 						},
 						func(s []*terraform.InstanceState) map[string]string {
 							for _, state := range s {
@@ -348,6 +356,107 @@ func TestAccResourceNLBService(t *testing.T) {
 	})
 }
 
+func TestAccResourceNLBService_HealthcheckDefaults(t *testing.T) {
+	var (
+		r          = "exoscale_nlb_service.test"
+		nlbService egoscale.NetworkLoadBalancerService
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckResourceNLBServiceDestroy(r),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+provider "exoscale" {
+  nlb_service_healthcheck_defaults {
+    mode     = "http"
+    uri      = "%s"
+    interval = %s
+  }
+}
+
+locals {
+  zone = "%s"
+}
+
+data "exoscale_compute_template" "template" {
+  zone = local.zone
+  name = "%s"
+}
+
+resource "exoscale_instance_pool" "test" {
+  zone          = local.zone
+  name          = "%s"
+  template_id   = data.exoscale_compute_template.template.id
+  instance_type = "standard.small"
+  size          = 1
+  disk_size     = 10
+
+  timeouts {
+    delete = "10m"
+  }
+}
+
+resource "exoscale_nlb" "test" {
+  name = "%s"
+  zone = local.zone
+
+  timeouts {
+    delete = "10m"
+  }
+}
+
+resource "exoscale_nlb_service" "test" {
+  zone             = local.zone
+  name             = "%s"
+  nlb_id           = exoscale_nlb.test.id
+  instance_pool_id = exoscale_instance_pool.test.id
+  port             = %s
+  target_port      = %s
+
+  timeouts {
+    delete = "10m"
+  }
+}
+`,
+					testAccResourceNLBServiceHealthcheckURI,
+					testAccResourceNLBServiceHealthcheckIntervalUpdated,
+					testZoneName,
+					testAccResourceNLBServiceTemplateName,
+					testAccResourceNLBServiceInstancePoolName,
+					testAccResourceNLBServiceNLBName,
+					testAccResourceNLBServiceName,
+					testAccResourceNLBServicePort,
+					testAccResourceNLBServiceTargetPort,
+				),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceNLBServiceExists(r, &nlbService),
+					func(s *terraform.State) error {
+						a := require.New(t)
+
+						a.Equal("http", *nlbService.Healthcheck.Mode)
+						a.Equal(testAccResourceNLBServiceHealthcheckURI, *nlbService.Healthcheck.URI)
+						a.Equal(testAccResourceNLBServiceTargetPort, fmt.Sprint(*nlbService.Healthcheck.Port))
+
+						return nil
+					},
+					checkResourceState(r, checkResourceStateValidateAttributes(testAttrs{
+						resNLBServiceAttrHealthcheck + ".#":                                         validateString("1"),
+						resNLBServiceAttrHealthcheck + ".0." + resNLBServiceAttrHealthcheckInterval: validateString(testAccResourceNLBServiceHealthcheckIntervalUpdated),
+						resNLBServiceAttrHealthcheck + ".0." + resNLBServiceAttrHealthcheckMode:     validateString("http"),
+						resNLBServiceAttrHealthcheck + ".0." + resNLBServiceAttrHealthcheckPort:     validateString(testAccResourceNLBServiceTargetPort),
+						resNLBServiceAttrHealthcheck + ".0." + resNLBServiceAttrHealthcheckRetries:  validateString(fmt.Sprint(defaultNLBServiceHealthcheckRetries)),
+						resNLBServiceAttrHealthcheck + ".0." + resNLBServiceAttrHealthcheckTimeout:  validateString(fmt.Sprint(defaultNLBServiceHealthcheckTimeout)),
+						resNLBServiceAttrHealthcheck + ".0." + resNLBServiceAttrHealthcheckURI:      validateString(testAccResourceNLBServiceHealthcheckURI),
+					})),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckResourceNLBServiceExists(r string, nlbService *egoscale.NetworkLoadBalancerService) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[r]
@@ -426,3 +535,151 @@ func testAccCheckResourceNLBServiceDestroy(r string) resource.TestCheckFunc {
 		return nil
 	}
 }
+
+func Test_resourceNLBServiceImport(t *testing.T) {
+	tests := []struct {
+		name      string
+		id        string
+		wantID    string
+		wantNLBID string
+		wantZone  string
+		wantErr   bool
+	}{
+		{
+			name:    "missing zone",
+			id:      "f81d4fae-7dec-11d0-a765-00a0c91e6bf6/9ecc6b8b-73d4-4211-8ced-f7f29bb79524",
+			wantErr: true,
+		},
+		{
+			name:    "missing NLB ID",
+			id:      "9ecc6b8b-73d4-4211-8ced-f7f29bb79524@ch-gva-2",
+			wantErr: true,
+		},
+		{
+			name:    "empty service ID",
+			id:      "f81d4fae-7dec-11d0-a765-00a0c91e6bf6/@ch-gva-2",
+			wantErr: true,
+		},
+		{
+			name:      "ok",
+			id:        "f81d4fae-7dec-11d0-a765-00a0c91e6bf6/9ecc6b8b-73d4-4211-8ced-f7f29bb79524@ch-gva-2",
+			wantID:    "9ecc6b8b-73d4-4211-8ced-f7f29bb79524",
+			wantNLBID: "f81d4fae-7dec-11d0-a765-00a0c91e6bf6",
+			wantZone:  "ch-gva-2",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := resourceNLBService().TestResourceData()
+			d.SetId(tt.id)
+
+			got, err := resourceNLBServiceImport(context.Background(), d, nil)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("resourceNLBServiceImport() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+
+			a := require.New(t)
+			a.Len(got, 1)
+			a.Equal(tt.wantID, got[0].Id())
+			a.Equal(tt.wantNLBID, got[0].Get(resNLBServiceAttrNLBID))
+			a.Equal(tt.wantZone, got[0].Get(resNLBServiceAttrZone))
+		})
+	}
+}
+
+func Test_resourceNLBServiceProtocol(t *testing.T) {
+	validate := resourceNLBService().Schema[resNLBServiceAttrProtocol].ValidateFunc
+
+	for _, protocol := range []string{"tcp", "udp"} {
+		_, errs := validate(protocol, resNLBServiceAttrProtocol)
+		require.Empty(t, errs, protocol)
+	}
+
+	for _, protocol := range []string{"http", "https", "TCP", ""} {
+		_, errs := validate(protocol, resNLBServiceAttrProtocol)
+		require.NotEmpty(t, errs, protocol)
+	}
+}
+
+func Test_validateNLBServiceHealthcheck(t *testing.T) {
+	tests := []struct {
+		name        string
+		healthcheck map[string]interface{}
+		wantErr     string
+	}{
+		{
+			name:        "tcp",
+			healthcheck: map[string]interface{}{"mode": "tcp"},
+		},
+		{
+			name:        "http with uri",
+			healthcheck: map[string]interface{}{"mode": "http", "uri": "/healthz"},
+		},
+		{
+			name:        "https with uri and tls_sni",
+			healthcheck: map[string]interface{}{"mode": "https", "uri": "/healthz", "tls_sni": "example.net"},
+		},
+		{
+			name:        "tcp with uri",
+			healthcheck: map[string]interface{}{"mode": "tcp", "uri": "/healthz"},
+			wantErr:     `healthcheck uri can only be set with the http and https modes (got "tcp")`,
+		},
+		{
+			name:        "http with tls_sni",
+			healthcheck: map[string]interface{}{"mode": "http", "uri": "/healthz", "tls_sni": "example.net"},
+			wantErr:     `healthcheck tls_sni can only be set with the https mode (got "http")`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateNLBServiceHealthcheck(tt.healthcheck)
+			if tt.wantErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.EqualError(t, err, tt.wantErr)
+		})
+	}
+}
+
+func Test_nlbServiceDefaultHealthcheck(t *testing.T) {
+	builtin := map[string]interface{}{
+		"interval": 10,
+		"mode":     "tcp",
+		"port":     8080,
+		"retries":  1,
+		"timeout":  5,
+		"tls_sni":  "",
+		"uri":      "",
+	}
+
+	require.Equal(t, builtin, nlbServiceDefaultHealthcheck(nil, 8080))
+
+	// Unset (zero) provider-level defaults don't override the built-in ones,
+	// and the healthcheck port is always the service target port.
+	require.Equal(t,
+		map[string]interface{}{
+			"interval": 5,
+			"mode":     "http",
+			"port":     8080,
+			"retries":  1,
+			"timeout":  5,
+			"tls_sni":  "",
+			"uri":      "/healthz",
+		},
+		nlbServiceDefaultHealthcheck(map[string]interface{}{
+			"interval": 5,
+			"mode":     "http",
+			"port":     80,
+			"retries":  0,
+			"tls_sni":  "",
+			"uri":      "/healthz",
+		}, 8080),
+	)
+}
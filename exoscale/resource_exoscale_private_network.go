@@ -14,6 +14,7 @@ import (
 	exoapi "github.com/exoscale/egoscale/v2/api"
 	"github.com/exoscale/terraform-provider-exoscale/pkg/config"
 	"github.com/exoscale/terraform-provider-exoscale/pkg/general"
+	"github.com/exoscale/terraform-provider-exoscale/pkg/utils"
 )
 
 const (
@@ -71,10 +72,11 @@ func resourcePrivateNetwork() *schema.Resource {
 				Description:  resPrivateNetworkDocHint + "The first/last IPv4 addresses used by the DHCP service for dynamic leases.",
 			},
 			resPrivateNetworkAttrZone: {
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
-				Description: "The Exoscale [Zone](https://www.exoscale.com/datacenters/) name.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				Description:      "The Exoscale [Zone](https://www.exoscale.com/datacenters/) name.",
+				ValidateDiagFunc: utils.ValidateZone(),
 			},
 		},
 
@@ -83,6 +85,8 @@ func resourcePrivateNetwork() *schema.Resource {
 		UpdateContext: resourcePrivateNetworkUpdate,
 		DeleteContext: resourcePrivateNetworkDelete,
 
+		CustomizeDiff: utils.QuotaCustomizeDiff("private-network"),
+
 		Importer: &schema.ResourceImporter{
 			StateContext: zonedStateContextFunc,
 		},
@@ -115,7 +115,7 @@ func resourceSecurityGroupCreate(ctx context.Context, d *schema.ResourceData, me
 		"id": resourceSecurityGroupIDString(d),
 	})
 
-	zone := defaultZone
+	zone := getDefaultZone(meta)
 
 	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutCreate))
 	ctx = exoapi.WithEndpoint(ctx, exoapi.NewReqEndpoint(getEnvironment(meta), zone))
@@ -153,7 +153,7 @@ func resourceSecurityGroupRead(ctx context.Context, d *schema.ResourceData, meta
 		"id": resourceSecurityGroupIDString(d),
 	})
 
-	zone := defaultZone
+	zone := getDefaultZone(meta)
 
 	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutRead))
 	ctx = exoapi.WithEndpoint(ctx, exoapi.NewReqEndpoint(getEnvironment(meta), zone))
@@ -183,7 +183,7 @@ func resourceSecurityGroupUpdate(ctx context.Context, d *schema.ResourceData, me
 		"id": resourceSecurityGroupIDString(d),
 	})
 
-	zone := defaultZone
+	zone := getDefaultZone(meta)
 
 	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutDelete))
 	ctx = exoapi.WithEndpoint(ctx, exoapi.NewReqEndpoint(getEnvironment(meta), zone))
@@ -240,7 +240,7 @@ func resourceSecurityGroupDelete(ctx context.Context, d *schema.ResourceData, me
 		"id": resourceSecurityGroupIDString(d),
 	})
 
-	zone := defaultZone
+	zone := getDefaultZone(meta)
 
 	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutDelete))
 	ctx = exoapi.WithEndpoint(ctx, exoapi.NewReqEndpoint(getEnvironment(meta), zone))
@@ -266,7 +266,7 @@ func resourceSecurityGroupImport(
 	d *schema.ResourceData,
 	meta interface{},
 ) ([]*schema.ResourceData, error) {
-	zone := defaultZone
+	zone := getDefaultZone(meta)
 
 	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutRead))
 	ctx = exoapi.WithEndpoint(ctx, exoapi.NewReqEndpoint(getEnvironment(meta), zone))
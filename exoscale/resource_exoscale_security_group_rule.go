@@ -216,7 +216,7 @@ func resourceSecurityGroupRuleCreate(ctx context.Context, d *schema.ResourceData
 		"id": resourceSecurityGroupRuleIDString(d),
 	})
 
-	zone := defaultZone
+	zone := getDefaultZone(meta)
 
 	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutCreate))
 	ctx = exoapi.WithEndpoint(ctx, exoapi.NewReqEndpoint(getEnvironment(meta), zone))
@@ -330,7 +330,7 @@ func resourceSecurityGroupRuleRead(ctx context.Context, d *schema.ResourceData,
 		"id": resourceSecurityGroupRuleIDString(d),
 	})
 
-	zone := defaultZone
+	zone := getDefaultZone(meta)
 
 	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutRead))
 	ctx = exoapi.WithEndpoint(ctx, exoapi.NewReqEndpoint(getEnvironment(meta), zone))
@@ -382,7 +382,7 @@ func resourceSecurityGroupRuleDelete(ctx context.Context, d *schema.ResourceData
 		"id": resourceSecurityGroupRuleIDString(d),
 	})
 
-	zone := defaultZone
+	zone := getDefaultZone(meta)
 
 	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutDelete))
 	ctx = exoapi.WithEndpoint(ctx, exoapi.NewReqEndpoint(getEnvironment(meta), zone))
@@ -428,7 +428,7 @@ func resourceSecurityGroupRuleApply(
 	securityGroup *egoscale.SecurityGroup,
 	securityGroupRule *egoscale.SecurityGroupRule,
 ) error {
-	zone := defaultZone
+	zone := getDefaultZone(meta)
 
 	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutCreate))
 	ctx = exoapi.WithEndpoint(ctx, exoapi.NewReqEndpoint(getEnvironment(meta), zone))
@@ -350,7 +350,7 @@ func resourceSecurityGroupRulesCreate(ctx context.Context, d *schema.ResourceDat
 		"id": resourceSecurityGroupRulesIDString(d),
 	})
 
-	zone := defaultZone
+	zone := getDefaultZone(meta)
 
 	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutCreate))
 	ctx = exoapi.WithEndpoint(ctx, exoapi.NewReqEndpoint(getEnvironment(meta), zone))
@@ -444,7 +444,7 @@ func resourceSecurityGroupRulesRead(ctx context.Context, d *schema.ResourceData,
 		"id": resourceSecurityGroupRulesIDString(d),
 	})
 
-	zone := defaultZone
+	zone := getDefaultZone(meta)
 
 	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutRead))
 	ctx = exoapi.WithEndpoint(ctx, exoapi.NewReqEndpoint(getEnvironment(meta), zone))
@@ -535,9 +535,9 @@ func resourceSecurityGroupRulesUpdate(ctx context.Context, d *schema.ResourceDat
 		"id": resourceSecurityGroupRulesIDString(d),
 	})
 
-	zone := defaultZone
+	zone := getDefaultZone(meta)
 
-	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutRead))
+	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutUpdate))
 	ctx = exoapi.WithEndpoint(ctx, exoapi.NewReqEndpoint(getEnvironment(meta), zone))
 	defer cancel()
 
@@ -614,9 +614,9 @@ func resourceSecurityGroupRulesDelete(ctx context.Context, d *schema.ResourceDat
 		"id": resourceSecurityGroupRulesIDString(d),
 	})
 
-	zone := defaultZone
+	zone := getDefaultZone(meta)
 
-	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutRead))
+	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutDelete))
 	ctx = exoapi.WithEndpoint(ctx, exoapi.NewReqEndpoint(getEnvironment(meta), zone))
 	defer cancel()
 
@@ -12,8 +12,10 @@ import (
 
 	egoscale "github.com/exoscale/egoscale/v2"
 	exoapi "github.com/exoscale/egoscale/v2/api"
+	"github.com/exoscale/egoscale/v2/oapi"
 	"github.com/exoscale/terraform-provider-exoscale/pkg/config"
 	"github.com/exoscale/terraform-provider-exoscale/pkg/general"
+	"github.com/exoscale/terraform-provider-exoscale/pkg/utils"
 )
 
 const (
@@ -80,6 +82,7 @@ func resourceSKSCluster() *schema.Resource {
 		resSKSClusterAttrCNI: {
 			Type:        schema.TypeString,
 			Optional:    true,
+			ForceNew:    true,
 			Default:     defaultSKSClusterCNI,
 			Description: fmt.Sprintf("The CNI plugin that is to be used. Defaults to %q.", defaultSKSClusterCNI),
 		},
@@ -107,7 +110,7 @@ func resourceSKSCluster() *schema.Resource {
 			Type:        schema.TypeBool,
 			Optional:    true,
 			Default:     true,
-			Description: "Deploy the Exoscale [Cloud Controller Manager](https://github.com/exoscale/exoscale-cloud-controller-manager/) in the control plane (boolean; default: `true`; may only be set at creation time).",
+			Description: "Deploy the Exoscale [Cloud Controller Manager](https://github.com/exoscale/exoscale-cloud-controller-manager/) in the control plane (boolean; default: `true`; updated in place).",
 		},
 		resSKSClusterAttrKubeletCA: {
 			Type:        schema.TypeString,
@@ -118,7 +121,7 @@ func resourceSKSCluster() *schema.Resource {
 			Type:        schema.TypeBool,
 			Optional:    true,
 			Default:     true,
-			Description: "Deploy the [Kubernetes Metrics Server](https://github.com/kubernetes-sigs/metrics-server/) in the control plane (boolean; default: `true`; may only be set at creation time).",
+			Description: "Deploy the [Kubernetes Metrics Server](https://github.com/kubernetes-sigs/metrics-server/) in the control plane (boolean; default: `true`; updated in place).",
 		},
 		resSKSClusterAttrLabels: {
 			Type:        schema.TypeMap,
@@ -208,10 +211,11 @@ func resourceSKSCluster() *schema.Resource {
 			Description: "The version of the control plane (default: latest version available from the API; see `exo compute sks versions` for reference; may only be set at creation time).",
 		},
 		resSKSClusterAttrZone: {
-			Type:        schema.TypeString,
-			Required:    true,
-			ForceNew:    true,
-			Description: "The Exoscale [Zone](https://www.exoscale.com/datacenters/) name.",
+			Type:             schema.TypeString,
+			Required:         true,
+			ForceNew:         true,
+			Description:      "The Exoscale [Zone](https://www.exoscale.com/datacenters/) name.",
+			ValidateDiagFunc: utils.ValidateZone(),
 		},
 	}
 
@@ -225,6 +229,8 @@ func resourceSKSCluster() *schema.Resource {
 		UpdateContext: resourceSKSClusterUpdate,
 		DeleteContext: resourceSKSClusterDelete,
 
+		CustomizeDiff: resourceSKSClusterCustomizeDiff,
+
 		Importer: &schema.ResourceImporter{
 			StateContext: zonedStateContextFunc,
 		},
@@ -238,6 +244,24 @@ func resourceSKSCluster() *schema.Resource {
 	}
 }
 
+// resourceSKSClusterReplacementImpacts describes the impact of replacing an SKS
+// cluster following a change of one of its create-only attributes.
+var resourceSKSClusterReplacementImpacts = func() map[string]string {
+	impact := "the cluster is deleted and a new one (with a new endpoint and new certificates) is created, " +
+		"the workloads and Kubernetes resources of the cluster being lost"
+
+	return map[string]string{
+		resSKSClusterAttrCNI:  impact,
+		resSKSClusterAttrZone: impact,
+	}
+}()
+
+func resourceSKSClusterCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	utils.WarnReplacement(ctx, d, resourceSKSClusterReplacementImpacts)
+
+	return nil
+}
+
 func resourceSKSClusterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	tflog.Debug(ctx, "beginning create", map[string]interface{}{
 		"id": resourceSKSClusterIDString(d),
@@ -439,6 +463,8 @@ func resourceSKSClusterUpdate(ctx context.Context, d *schema.ResourceData, meta
 	}
 
 	if d.HasChange(resSKSClusterAttrLabels) {
+		// Labels are replaced as a whole: keys removed from the configuration
+		// are cleared server-side, including when all labels are removed.
 		labels := make(map[string]string)
 		for k, v := range d.Get(resSKSClusterAttrLabels).(map[string]interface{}) {
 			labels[k] = v.(string)
@@ -465,6 +491,22 @@ func resourceSKSClusterUpdate(ctx context.Context, d *schema.ResourceData, meta
 		}
 	}
 
+	if d.HasChanges(resSKSClusterAttrExoscaleCCM, resSKSClusterAttrMetricsServer) {
+		var current []string
+		if sksCluster.AddOns != nil {
+			current = *sksCluster.AddOns
+		}
+
+		addOns := sksClusterUpdatedAddOns(
+			current,
+			d.Get(resSKSClusterAttrExoscaleCCM).(bool),
+			d.Get(resSKSClusterAttrMetricsServer).(bool),
+		)
+		if err = updateSKSClusterAddOns(ctx, client.Client, zone, d, addOns); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	tflog.Debug(ctx, "update finished successfully", map[string]interface{}{
 		"id": resourceSKSClusterIDString(d),
 	})
@@ -498,7 +540,65 @@ func resourceSKSClusterDelete(ctx context.Context, d *schema.ResourceData, meta
 	return nil
 }
 
+// sksClusterUpdatedAddOns returns the cluster add-ons once the Exoscale CCM and
+// Metrics Server add-ons enabled or disabled, the other add-ons being kept.
+func sksClusterUpdatedAddOns(current []string, enableCCM, enableMS bool) []string {
+	addOns := make([]string, 0, len(current)+2)
+	for _, a := range current {
+		if a != sksClusterAddonExoscaleCCM && a != sksClusterAddonMS {
+			addOns = append(addOns, a)
+		}
+	}
+
+	if enableCCM {
+		addOns = append(addOns, sksClusterAddonExoscaleCCM)
+	}
+	if enableMS {
+		addOns = append(addOns, sksClusterAddonMS)
+	}
+
+	return addOns
+}
+
+// updateSKSClusterAddOns updates the add-ons of an SKS cluster in place: the API
+// supports it, but not the egoscale UpdateSKSCluster() method.
+func updateSKSClusterAddOns(
+	ctx context.Context,
+	client *egoscale.Client,
+	zone string,
+	d *schema.ResourceData,
+	addOns []string,
+) error {
+	body := oapi.UpdateSksClusterJSONRequestBody{
+		Addons: func() *[]oapi.UpdateSksClusterJSONBodyAddons {
+			v := make([]oapi.UpdateSksClusterJSONBodyAddons, len(addOns))
+			for i, a := range addOns {
+				v[i] = oapi.UpdateSksClusterJSONBodyAddons(a)
+			}
+			return &v
+		}(),
+		// The description is always sent by the API client (cleared if null).
+		Description: nonEmptyStringPtr(d.Get(resSKSClusterAttrDescription).(string)),
+	}
+
+	resp, err := client.UpdateSksClusterWithResponse(ctx, d.Id(), body)
+	if err != nil {
+		return fmt.Errorf("unable to update SKS cluster add-ons: %w", err)
+	}
+	if resp.JSON200 == nil {
+		return fmt.Errorf("unable to update SKS cluster add-ons: unexpected response status %s", resp.Status())
+	}
+
+	if _, err := oapi.NewPoller().Poll(ctx, oapi.OperationPoller(client, zone, *resp.JSON200.Id)); err != nil {
+		return fmt.Errorf("unable to update SKS cluster add-ons: %w", err)
+	}
+
+	return nil
+}
+
 func resourceSKSClusterApply(_ context.Context, d *schema.ResourceData, sksCluster *egoscale.SKSCluster, certificates *SKSClusterCertificates) error {
+	// The add-ons drift is only detected if the API reports the cluster add-ons,
+	// the "exoscale_ccm"/"metrics_server" attributes being kept as is otherwise.
 	if sksCluster.AddOns != nil {
 		if err := d.Set(resSKSClusterAttrAddons, *sksCluster.AddOns); err != nil {
 			return err
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	egoscale "github.com/exoscale/egoscale/v2"
 	exoapi "github.com/exoscale/egoscale/v2/api"
@@ -367,6 +368,78 @@ func TestAccResourceSKSCluster(t *testing.T) {
 	})
 }
 
+func TestAccResourceSKSCluster_Labels(t *testing.T) {
+	var (
+		r          = "exoscale_sks_cluster.test"
+		sksCluster egoscale.SKSCluster
+		configFmt  = `
+resource "exoscale_sks_cluster" "test" {
+  zone = "%s"
+  name = "%s"
+  exoscale_ccm = false
+  metrics_server = false
+  %s
+
+  timeouts {
+    create = "10m"
+  }
+}`
+	)
+
+	testAccCheckLabels := func(expected map[string]string) resource.TestCheckFunc {
+		return func(s *terraform.State) error {
+			labels := make(map[string]string)
+			if sksCluster.Labels != nil {
+				labels = *sksCluster.Labels
+			}
+
+			if !assert.Equal(t, expected, labels) {
+				return fmt.Errorf("unexpected SKS cluster labels: %v", labels)
+			}
+
+			return nil
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckResourceSKSClusterDestroy(&sksCluster),
+		Steps: []resource.TestStep{
+			{
+				// Create with two labels
+				Config: fmt.Sprintf(configFmt, testZoneName, testAccResourceSKSClusterName, `labels = {
+    a = "1"
+    b = "2"
+  }`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceSKSClusterExists(r, &sksCluster),
+					testAccCheckLabels(map[string]string{"a": "1", "b": "2"}),
+				),
+			},
+			{
+				// Remove one label
+				Config: fmt.Sprintf(configFmt, testZoneName, testAccResourceSKSClusterName, `labels = {
+    a = "1"
+  }`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceSKSClusterExists(r, &sksCluster),
+					testAccCheckLabels(map[string]string{"a": "1"}),
+					resource.TestCheckResourceAttr(r, resSKSClusterAttrLabels+".%", "1"),
+				),
+			},
+			{
+				// Remove all labels
+				Config: fmt.Sprintf(configFmt, testZoneName, testAccResourceSKSClusterName, ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceSKSClusterExists(r, &sksCluster),
+					testAccCheckLabels(map[string]string{}),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckResourceSKSClusterExists(r string, sksCluster *egoscale.SKSCluster) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[r]
@@ -414,3 +487,17 @@ func testAccCheckResourceSKSClusterDestroy(sksCluster *egoscale.SKSCluster) reso
 		return errors.New("SKS cluster still exists")
 	}
 }
+
+func Test_sksClusterUpdatedAddOns(t *testing.T) {
+	current := []string{sksClusterAddonExoscaleCCM, "exoscale-container-storage-interface"}
+
+	require.ElementsMatch(t,
+		[]string{"exoscale-container-storage-interface", sksClusterAddonMS},
+		sksClusterUpdatedAddOns(current, false, true),
+	)
+	require.ElementsMatch(t,
+		[]string{sksClusterAddonExoscaleCCM, sksClusterAddonMS},
+		sksClusterUpdatedAddOns(nil, true, true),
+	)
+	require.Empty(t, sksClusterUpdatedAddOns(nil, false, false))
+}
@@ -18,6 +18,7 @@ import (
 	exoapi "github.com/exoscale/egoscale/v2/api"
 	"github.com/exoscale/terraform-provider-exoscale/pkg/config"
 	"github.com/exoscale/terraform-provider-exoscale/pkg/general"
+	"github.com/exoscale/terraform-provider-exoscale/pkg/utils"
 )
 
 const (
@@ -81,10 +82,11 @@ func resourceSKSKubeconfig() *schema.Resource {
 			Description: "User name in the generated Kubeconfig. The certificate present in the Kubeconfig will also have this name set for the CN field.",
 		},
 		resSKSKubeconfigAttrZone: {
-			Type:        schema.TypeString,
-			Required:    true,
-			ForceNew:    true,
-			Description: "The Exoscale [Zone](https://www.exoscale.com/datacenters/) name.",
+			Type:             schema.TypeString,
+			Required:         true,
+			ForceNew:         true,
+			Description:      "The Exoscale [Zone](https://www.exoscale.com/datacenters/) name.",
+			ValidateDiagFunc: utils.ValidateZone(),
 		},
 	}
 
@@ -189,26 +191,15 @@ func resourceSKSKubeconfigDelete(ctx context.Context, d *schema.ResourceData, me
 }
 
 func resourceSKSKubeconfigDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
-	kubeconfig := d.Get(resSKSKubeconfigAttrKubeconfig).(string)
-
-	clusterCerts, clientCerts, err := KubeconfigExtractCertificates(kubeconfig)
+	readyForRenewal, err := kubeconfigReadyForRenewal(
+		d.Get(resSKSKubeconfigAttrKubeconfig).(string),
+		time.Duration(d.Get(resSKSKubeconfigAttrEarlyRenewalSeconds).(int))*time.Second,
+		time.Now(),
+	)
 	if err != nil {
 		return err
 	}
 
-	readyForRenewal := len(kubeconfig) == 0
-	if !readyForRenewal {
-		now := time.Now()
-		earlyRenewalSeconds := d.Get(resSKSKubeconfigAttrEarlyRenewalSeconds).(int)
-		earlyRenewalPeriod := time.Duration(-earlyRenewalSeconds) * time.Second
-
-		for _, certificate := range append(clusterCerts, clientCerts...) {
-			if certificate.NotAfter.Add(earlyRenewalPeriod).Sub(now) <= 0 {
-				readyForRenewal = true
-			}
-		}
-	}
-
 	if readyForRenewal {
 		if err := d.SetNew(resSKSKubeconfigAttrReadyForRenewal, true); err != nil {
 			return err
@@ -222,6 +213,27 @@ func resourceSKSKubeconfigDiff(ctx context.Context, d *schema.ResourceDiff, meta
 	return nil
 }
 
+// kubeconfigReadyForRenewal reports whether the kubeconfig must be (re-)generated,
+// i.e. if it is empty or if any of its certificates expires within earlyRenewal of now.
+func kubeconfigReadyForRenewal(kubeconfig string, earlyRenewal time.Duration, now time.Time) (bool, error) {
+	if len(kubeconfig) == 0 {
+		return true, nil
+	}
+
+	clusterCerts, clientCerts, err := KubeconfigExtractCertificates(kubeconfig)
+	if err != nil {
+		return false, err
+	}
+
+	for _, certificate := range append(clusterCerts, clientCerts...) {
+		if certificate.NotAfter.Add(-earlyRenewal).Sub(now) <= 0 {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
 func KubeconfigExtractCertificates(kubeconfig string) ([]*x509.Certificate, []*x509.Certificate, error) {
 	if len(kubeconfig) == 0 {
 		return []*x509.Certificate{}, []*x509.Certificate{}, nil
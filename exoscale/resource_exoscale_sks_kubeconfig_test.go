@@ -1,10 +1,19 @@
 package exoscale
 
 import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
 	"errors"
 	"fmt"
+	"math/big"
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -117,3 +126,85 @@ func testAccCheckResourceSKSKubeconfigExists(r string, sksKubeconfig *string) re
 		return nil
 	}
 }
+
+func Test_kubeconfigReadyForRenewal(t *testing.T) {
+	now := time.Now()
+
+	testCertificate := func(notAfter time.Time) string {
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		require.NoError(t, err)
+
+		template := &x509.Certificate{
+			SerialNumber: big.NewInt(1),
+			Subject:      pkix.Name{CommonName: "test"},
+			NotBefore:    now.Add(-time.Hour),
+			NotAfter:     notAfter,
+		}
+		der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+		require.NoError(t, err)
+
+		return base64.StdEncoding.EncodeToString(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+	}
+
+	testKubeconfig := func(caNotAfter, clientNotAfter time.Time) string {
+		return fmt.Sprintf(`
+clusters:
+- cluster:
+    certificate-authority-data: %s
+users:
+- user:
+    client-certificate-data: %s
+`,
+			testCertificate(caNotAfter),
+			testCertificate(clientNotAfter),
+		)
+	}
+
+	tests := []struct {
+		name         string
+		kubeconfig   string
+		earlyRenewal time.Duration
+		want         bool
+	}{
+		{
+			name: "empty kubeconfig",
+			want: true,
+		},
+		{
+			name:       "valid certificates",
+			kubeconfig: testKubeconfig(now.Add(365*24*time.Hour), now.Add(24*time.Hour)),
+			want:       false,
+		},
+		{
+			name:       "expired client certificate",
+			kubeconfig: testKubeconfig(now.Add(365*24*time.Hour), now.Add(-time.Minute)),
+			want:       true,
+		},
+		{
+			name:         "client certificate within early renewal period",
+			kubeconfig:   testKubeconfig(now.Add(365*24*time.Hour), now.Add(time.Hour)),
+			earlyRenewal: 2 * time.Hour,
+			want:         true,
+		},
+		{
+			name:         "client certificate outside early renewal period",
+			kubeconfig:   testKubeconfig(now.Add(365*24*time.Hour), now.Add(3*time.Hour)),
+			earlyRenewal: 2 * time.Hour,
+			want:         false,
+		},
+		{
+			name:         "CA certificate within early renewal period",
+			kubeconfig:   testKubeconfig(now.Add(time.Hour), now.Add(24*time.Hour)),
+			earlyRenewal: 2 * time.Hour,
+			want:         true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := kubeconfigReadyForRenewal(tt.kubeconfig, tt.earlyRenewal, now)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
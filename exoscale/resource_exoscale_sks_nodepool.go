@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -14,6 +15,7 @@ import (
 	exoapi "github.com/exoscale/egoscale/v2/api"
 	"github.com/exoscale/terraform-provider-exoscale/pkg/config"
 	"github.com/exoscale/terraform-provider-exoscale/pkg/general"
+	"github.com/exoscale/terraform-provider-exoscale/pkg/utils"
 )
 
 const (
@@ -35,6 +37,7 @@ const (
 	resSKSNodepoolAttrID                   = "id"
 	resSKSNodepoolAttrName                 = "name"
 	resSKSNodepoolAttrPrivateNetworkIDs    = "private_network_ids"
+	resSKSNodepoolAttrReplaceOutdatedNodes = "replace_outdated_nodes"
 	resSKSNodepoolAttrSecurityGroupIDs     = "security_group_ids"
 	resSKSNodepoolAttrSize                 = "size"
 	resSKSNodepoolAttrState                = "state"
@@ -56,7 +59,7 @@ func resourceSKSNodepool() *schema.Resource {
 			Optional:    true,
 			Set:         schema.HashString,
 			Elem:        &schema.Schema{Type: schema.TypeString},
-			Description: "A list of [exoscale_anti_affinity_group](./anti_affinity_group.md) (IDs) to be attached to the managed instances.",
+			Description: "A list of [exoscale_anti_affinity_group](./anti_affinity_group.md) (IDs) to be attached to the managed instances. Changes only apply to new Nodes: existing Nodes keep their placement until replaced (see `replace_outdated_nodes`).",
 		},
 		resSKSNodepoolAttrClusterID: {
 			Type:        schema.TypeString,
@@ -91,10 +94,11 @@ func resourceSKSNodepool() *schema.Resource {
 			Description: "The underlying [exoscale_instance_pool](./instance_pool.md) ID.",
 		},
 		resSKSNodepoolAttrInstancePrefix: {
-			Type:        schema.TypeString,
-			Optional:    true,
-			Default:     defaultSKSNodepoolInstancePrefix,
-			Description: "The string used to prefix the managed instances name (default `pool`).",
+			Type:             schema.TypeString,
+			Optional:         true,
+			Default:          defaultSKSNodepoolInstancePrefix,
+			ValidateDiagFunc: utils.ValidateInstancePrefix,
+			Description:      fmt.Sprintf("The string used to prefix the managed instances name (letters, digits and hyphens; at most %d characters; default `pool`).", utils.InstancePrefixMaxLength),
 		},
 		resSKSNodepoolAttrInstanceType: {
 			Type:             schema.TypeString,
@@ -127,6 +131,12 @@ func resourceSKSNodepool() *schema.Resource {
 			Elem:        &schema.Schema{Type: schema.TypeString},
 			Description: "A list of [exoscale_private_network](./private_network.md) (IDs) to be attached to the managed instances.",
 		},
+		resSKSNodepoolAttrReplaceOutdatedNodes: {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Replace the existing Kubernetes Nodes one at a time when `instance_type` or `anti_affinity_group_ids` change, by scaling out with an up-to-date Node before evicting an outdated one (default: `false`, only new Nodes use the new settings). The outdated Nodes are **not** cordoned nor drained by the provider: their Pods are terminated along with the Node, so make sure your workloads tolerate it (e.g. with enough replicas), or drain the Nodes beforehand (e.g. using `kubectl drain`).",
+		},
 		resSKSNodepoolAttrSecurityGroupIDs: {
 			Type:        schema.TypeSet,
 			Optional:    true,
@@ -166,10 +176,11 @@ func resourceSKSNodepool() *schema.Resource {
 			Description: "The managed instances version.",
 		},
 		resSKSNodepoolAttrZone: {
-			Type:        schema.TypeString,
-			Required:    true,
-			ForceNew:    true,
-			Description: "The Exoscale [Zone](https://www.exoscale.com/datacenters/) name.",
+			Type:             schema.TypeString,
+			Required:         true,
+			ForceNew:         true,
+			Description:      "The Exoscale [Zone](https://www.exoscale.com/datacenters/) name.",
+			ValidateDiagFunc: utils.ValidateZone(),
 		},
 	}
 
@@ -201,6 +212,10 @@ func resourceSKSNodepool() *schema.Resource {
 					return nil, err
 				}
 
+				if err := d.Set(resSKSNodepoolAttrReplaceOutdatedNodes, false); err != nil {
+					return nil, err
+				}
+
 				return []*schema.ResourceData{d}, nil
 			},
 		},
@@ -528,6 +543,13 @@ func resourceSKSNodepoolUpdate(ctx context.Context, d *schema.ResourceData, meta
 		}
 	}
 
+	if (d.HasChange(resSKSNodepoolAttrInstanceType) || d.HasChange(resSKSNodepoolAttrAntiAffinityGroupIDs)) &&
+		d.Get(resSKSNodepoolAttrReplaceOutdatedNodes).(bool) {
+		if err = sksNodepoolReplaceOutdatedNodes(ctx, client.Client, zone, sksCluster, sksNodepool); err != nil {
+			return diag.Errorf("error replacing SKS Nodepool members: %s", err)
+		}
+	}
+
 	if d.HasChange(resSKSNodepoolAttrSize) {
 		if err = client.ScaleSKSNodepool(
 			ctx,
@@ -547,6 +569,98 @@ func resourceSKSNodepoolUpdate(ctx context.Context, d *schema.ResourceData, meta
 	return resourceSKSNodepoolRead(ctx, d, meta)
 }
 
+// sksNodepoolReplaceOutdatedNodes replaces the Nodepool members not matching the Nodepool instance type or
+// Anti-Affinity Groups one at a time: the Nodepool is scaled out by one up-to-date Node, then an outdated Node is
+// evicted. Draining the Nodes requires access to the Kubernetes API, which is out of the scope of the provider:
+// evicted Nodes are not cordoned nor drained beforehand. The Nodepool size is re-read before each step, so that
+// changes made by the cluster autoscaler in the meantime are preserved.
+func sksNodepoolReplaceOutdatedNodes(
+	ctx context.Context,
+	client *egoscale.Client,
+	zone string,
+	cluster *egoscale.SKSCluster,
+	nodepool *egoscale.SKSNodepool,
+) error {
+	pool, err := client.GetInstancePool(ctx, zone, *nodepool.InstancePoolID)
+	if err != nil {
+		return err
+	}
+
+	outdated := make([]string, 0)
+	if pool.InstanceIDs != nil {
+		for _, instanceID := range *pool.InstanceIDs {
+			instance, err := client.GetInstance(ctx, zone, instanceID)
+			if err != nil {
+				return err
+			}
+
+			if sksNodepoolMemberOutdated(instance, nodepool) {
+				outdated = append(outdated, instanceID)
+			}
+		}
+	}
+
+	for i, instanceID := range outdated {
+		pool, err := client.GetInstancePool(ctx, zone, *nodepool.InstancePoolID)
+		if err != nil {
+			return err
+		}
+
+		// The member may have been removed by the cluster autoscaler in the meantime.
+		var member bool
+		if pool.InstanceIDs != nil {
+			for _, id := range *pool.InstanceIDs {
+				if id == instanceID {
+					member = true
+					break
+				}
+			}
+		}
+		if !member {
+			continue
+		}
+
+		tflog.Info(ctx, "replacing SKS Nodepool member", map[string]interface{}{
+			"id":       *nodepool.ID,
+			"instance": instanceID,
+			"progress": fmt.Sprintf("%d/%d", i+1, len(outdated)),
+		})
+
+		if err := client.ScaleSKSNodepool(ctx, zone, cluster, nodepool, *pool.Size+1); err != nil {
+			return err
+		}
+
+		if err := client.WaitInstancePoolConverged(ctx, zone, *pool.ID); err != nil {
+			return err
+		}
+
+		if err := client.EvictSKSNodepoolMembers(ctx, zone, cluster, nodepool, []string{instanceID}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// sksNodepoolMemberOutdated returns true if the Nodepool member instance type or
+// Anti-Affinity Groups differ from the Nodepool ones.
+func sksNodepoolMemberOutdated(instance *egoscale.Instance, nodepool *egoscale.SKSNodepool) bool {
+	if defaultString(instance.InstanceTypeID, "") != defaultString(nodepool.InstanceTypeID, "") {
+		return true
+	}
+
+	sorted := func(ids *[]string) []string {
+		if ids == nil {
+			return []string{}
+		}
+		list := append([]string{}, *ids...)
+		sort.Strings(list)
+		return list
+	}
+
+	return strings.Join(sorted(instance.AntiAffinityGroupIDs), ",") != strings.Join(sorted(nodepool.AntiAffinityGroupIDs), ",")
+}
+
 func resourceSKSNodepoolDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	tflog.Debug(ctx, "beginning delete", map[string]interface{}{
 		"id": resourceSKSNodepoolIDString(d),
@@ -637,7 +751,13 @@ func resourceSKSNodepoolApply(
 		return err
 	}
 
-	if err := d.Set(resSKSNodepoolAttrLabels, sksNodepool.Labels); err != nil {
+	// Setting a nil map would leave the previous value in place, hiding the
+	// labels removed out-of-band and leaking them into imported states.
+	labels := make(map[string]string)
+	if sksNodepool.Labels != nil {
+		labels = *sksNodepool.Labels
+	}
+	if err := d.Set(resSKSNodepoolAttrLabels, labels); err != nil {
 		return err
 	}
 
@@ -129,6 +129,7 @@ resource "exoscale_sks_nodepool" "test" {
   labels = { test = "%s" }
   taints = { test = "%s:%s" }
   storage_lvm = %t
+  replace_outdated_nodes = true
 
   timeouts {
     delete = "10m"
@@ -150,6 +151,79 @@ resource "exoscale_sks_nodepool" "test" {
 		testAccResourceSKSNodepoolTaintEffect,
 		testAccResourceSKSNodepoolStorageLVM,
 	)
+
+	testAccResourceSKSNodepoolConfigUpdateAntiAffinityGroups = fmt.Sprintf(`
+locals {
+  zone = "%s"
+}
+
+data "exoscale_security_group" "default" {
+  name = "default"
+}
+
+resource "exoscale_affinity" "test" {
+  name = "%s"
+}
+
+resource "exoscale_affinity" "test2" {
+  name = "%s-2"
+}
+
+resource "exoscale_network" "test" {
+  zone     = local.zone
+  name     = "%s"
+  start_ip = "10.0.0.20"
+  end_ip   = "10.0.0.253"
+  netmask  = "255.255.255.0"
+}
+
+resource "exoscale_sks_cluster" "test" {
+  zone = local.zone
+  name = "%s"
+
+  timeouts {
+    delete = "10m"
+  }
+}
+
+resource "exoscale_sks_nodepool" "test" {
+  zone = local.zone
+  cluster_id = exoscale_sks_cluster.test.id
+  name = "%s"
+  description = "%s"
+  instance_type = "%s"
+  disk_size = %d
+  size = %d
+  instance_prefix = "%s"
+  anti_affinity_group_ids = [exoscale_affinity.test.id, exoscale_affinity.test2.id]
+  security_group_ids = [data.exoscale_security_group.default.id]
+  private_network_ids = [exoscale_network.test.id]
+  labels = { test = "%s" }
+  taints = { test = "%s:%s" }
+  storage_lvm = %t
+  replace_outdated_nodes = true
+
+  timeouts {
+    delete = "10m"
+  }
+}
+	  `,
+		testZoneName,
+		testAccResourceSKSNodepoolAntiAffinityGroupName,
+		testAccResourceSKSNodepoolAntiAffinityGroupName,
+		testAccResourceSKSNodepoolPrivateNetworkName,
+		testAccResourceSKSClusterName,
+		testAccResourceSKSNodepoolNameUpdated,
+		testAccResourceSKSNodepoolDescriptionUpdated,
+		testAccResourceSKSNodepoolInstanceTypeUpdated,
+		testAccResourceSKSNodepoolDiskSizeUpdated,
+		testAccResourceSKSNodepoolSizeUpdated,
+		defaultSKSNodepoolInstancePrefix,
+		testAccResourceSKSNodepoolLabelValueUpdated,
+		testAccResourceSKSNodepoolTaintValueUpdated,
+		testAccResourceSKSNodepoolTaintEffect,
+		testAccResourceSKSNodepoolStorageLVM,
+	)
 )
 
 func TestAccResourceSKSNodepool(t *testing.T) {
@@ -224,6 +298,7 @@ func TestAccResourceSKSNodepool(t *testing.T) {
 						a.Equal(testAccResourceSKSNodepoolNameUpdated, *sksNodepool.Name)
 						a.Equal(defaultSKSNodepoolInstancePrefix, *sksNodepool.InstancePrefix)
 						a.Equal(testInstanceTypeIDMedium, *sksNodepool.InstanceTypeID)
+						a.NoError(testAccCheckSKSNodepoolMembersInstanceType(&sksNodepool, testInstanceTypeIDMedium))
 						a.Len(*sksNodepool.PrivateNetworkIDs, 1)
 						a.Len(*sksNodepool.SecurityGroupIDs, 1)
 						a.Equal(testAccResourceSKSNodepoolSizeUpdated, *sksNodepool.Size)
@@ -258,6 +333,24 @@ func TestAccResourceSKSNodepool(t *testing.T) {
 					})),
 				),
 			},
+			{
+				// Update: a second Anti-Affinity Group, rolled out to the existing Nodes
+				Config: testAccResourceSKSNodepoolConfigUpdateAntiAffinityGroups,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceSKSNodepoolExists(r, &sksNodepool),
+					func(s *terraform.State) error {
+						a := require.New(t)
+
+						a.Len(*sksNodepool.AntiAffinityGroupIDs, 2)
+						a.NoError(testAccCheckSKSNodepoolMembersUpToDate(&sksNodepool))
+
+						return nil
+					},
+					checkResourceState(r, checkResourceStateValidateAttributes(testAttrs{
+						resSKSNodepoolAttrAntiAffinityGroupIDs + ".#": validateString("2"),
+					})),
+				),
+			},
 			{
 				// Import
 				ResourceName: r,
@@ -269,12 +362,13 @@ func TestAccResourceSKSNodepool(t *testing.T) {
 						return fmt.Sprintf("%s/%s@%s", *sksCluster.ID, *sksNodepool.ID, testZoneName), nil
 					}
 				}(&sksCluster, &sksNodepool),
-				ImportState:       true,
-				ImportStateVerify: true,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{resSKSNodepoolAttrReplaceOutdatedNodes},
 				ImportStateCheck: func(s []*terraform.InstanceState) error {
 					return checkResourceAttributes(
 						testAttrs{
-							resSKSNodepoolAttrAntiAffinityGroupIDs + ".#": validateString("1"),
+							resSKSNodepoolAttrAntiAffinityGroupIDs + ".#": validateString("2"),
 							resSKSNodepoolAttrClusterID:                   validation.ToDiagFunc(validation.IsUUID),
 							resSKSNodepoolAttrCreatedAt:                   validation.ToDiagFunc(validation.NoZeroValues),
 							resSKSNodepoolAttrDescription:                 validateString(testAccResourceSKSNodepoolDescriptionUpdated),
@@ -282,6 +376,7 @@ func TestAccResourceSKSNodepool(t *testing.T) {
 							resSKSNodepoolAttrInstancePoolID:              validation.ToDiagFunc(validation.IsUUID),
 							resSKSNodepoolAttrInstancePrefix:              validateString(defaultSKSNodepoolInstancePrefix),
 							resSKSNodepoolAttrInstanceType:                validateString(testAccResourceSKSNodepoolInstanceTypeUpdated),
+							resSKSNodepoolAttrLabels + ".%":               validateString("1"),
 							resSKSNodepoolAttrLabels + ".test":            validateString(testAccResourceSKSNodepoolLabelValueUpdated),
 							resSKSNodepoolAttrName:                        validateString(testAccResourceSKSNodepoolNameUpdated),
 							resSKSNodepoolAttrPrivateNetworkIDs + ".#":    validateString("1"),
@@ -367,6 +462,88 @@ func testAccCheckResourceSKSNodepoolExists(r string, sksNodepool *egoscale.SKSNo
 	}
 }
 
+func testAccCheckSKSNodepoolMembersInstanceType(sksNodepool *egoscale.SKSNodepool, instanceTypeID string) error {
+	client := GetComputeClient(testAccProvider.Meta())
+	ctx := exoapi.WithEndpoint(
+		context.Background(),
+		exoapi.NewReqEndpoint(testEnvironment, testZoneName),
+	)
+
+	pool, err := client.GetInstancePool(ctx, testZoneName, *sksNodepool.InstancePoolID)
+	if err != nil {
+		return err
+	}
+
+	for _, instanceID := range *pool.InstanceIDs {
+		instance, err := client.GetInstance(ctx, testZoneName, instanceID)
+		if err != nil {
+			return err
+		}
+
+		if *instance.InstanceTypeID != instanceTypeID {
+			return fmt.Errorf("SKS Nodepool member %q has instance type %q, expected %q", instanceID, *instance.InstanceTypeID, instanceTypeID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckSKSNodepoolMembersUpToDate(sksNodepool *egoscale.SKSNodepool) error {
+	client := GetComputeClient(testAccProvider.Meta())
+	ctx := exoapi.WithEndpoint(
+		context.Background(),
+		exoapi.NewReqEndpoint(testEnvironment, testZoneName),
+	)
+
+	pool, err := client.GetInstancePool(ctx, testZoneName, *sksNodepool.InstancePoolID)
+	if err != nil {
+		return err
+	}
+
+	for _, instanceID := range *pool.InstanceIDs {
+		instance, err := client.GetInstance(ctx, testZoneName, instanceID)
+		if err != nil {
+			return err
+		}
+
+		if sksNodepoolMemberOutdated(instance, sksNodepool) {
+			return fmt.Errorf("SKS Nodepool member %q has not been replaced", instanceID)
+		}
+	}
+
+	return nil
+}
+
+func Test_sksNodepoolMemberOutdated(t *testing.T) {
+	ids := func(v ...string) *[]string { return &v }
+	typeID := func(v string) *string { return &v }
+
+	nodepool := &egoscale.SKSNodepool{
+		InstanceTypeID:       typeID("small"),
+		AntiAffinityGroupIDs: ids("aag1", "aag2"),
+	}
+
+	require.False(t, sksNodepoolMemberOutdated(&egoscale.Instance{
+		InstanceTypeID:       typeID("small"),
+		AntiAffinityGroupIDs: ids("aag2", "aag1"),
+	}, nodepool))
+
+	require.True(t, sksNodepoolMemberOutdated(&egoscale.Instance{
+		InstanceTypeID:       typeID("medium"),
+		AntiAffinityGroupIDs: ids("aag1", "aag2"),
+	}, nodepool), "expected a member of another instance type to be outdated")
+
+	require.True(t, sksNodepoolMemberOutdated(&egoscale.Instance{
+		InstanceTypeID:       typeID("small"),
+		AntiAffinityGroupIDs: ids("aag1"),
+	}, nodepool), "expected a member missing an Anti-Affinity Group to be outdated")
+
+	require.False(t, sksNodepoolMemberOutdated(
+		&egoscale.Instance{InstanceTypeID: typeID("small")},
+		&egoscale.SKSNodepool{InstanceTypeID: typeID("small"), AntiAffinityGroupIDs: ids()},
+	))
+}
+
 func testAccCheckResourceSKSNodepoolDestroy(r string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[r]
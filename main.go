@@ -34,7 +34,7 @@ func main() {
 
 	upgradedProvider, err := tf5to6server.UpgradeServer(
 		ctx,
-		exoscale.Provider().GRPCProvider,
+		exoscale.ProviderServer,
 	)
 	check(err)
 
@@ -47,3 +47,21 @@ func GetEnvironment(meta interface{}) string {
 	}
 	return DefaultEnvironment
 }
+
+// GetDefaultZone returns the zone used for global resources management
+func GetDefaultZone(meta interface{}) string {
+	c := meta.(map[string]interface{})
+	if zone, ok := c["default_zone"]; ok && zone.(string) != "" {
+		return zone.(string)
+	}
+	return DefaultZone
+}
+
+// QuotaChecksEnabled reports whether quota checks must be performed before creating resources
+func QuotaChecksEnabled(meta interface{}) bool {
+	c := meta.(map[string]interface{})
+	if enabled, ok := c["quota_checks"]; ok {
+		return enabled.(bool)
+	}
+	return false
+}
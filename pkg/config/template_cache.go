@@ -0,0 +1,88 @@
+package config
+
+import (
+	"sync"
+	"time"
+)
+
+// TemplateCacheTTL is the lifetime of the template name to ID resolutions cached
+// by the provider, after which a template name is resolved again (e.g. to pick a
+// newer template revision published meanwhile).
+const TemplateCacheTTL = 10 * time.Minute
+
+// TemplateCache caches the template name to ID resolutions per zone. A TemplateCache
+// lives as long as the provider configuration it is stored in, i.e. it is scoped to
+// the provider credentials. A nil *TemplateCache is valid and disables caching.
+type TemplateCache struct {
+	ttl time.Duration
+	now func() time.Time
+
+	mu      sync.Mutex
+	entries map[templateCacheKey]templateCacheEntry
+}
+
+type templateCacheKey struct {
+	zone string
+	name string
+}
+
+type templateCacheEntry struct {
+	id      string
+	expires time.Time
+}
+
+// NewTemplateCache returns an empty TemplateCache whose entries expire after ttl.
+func NewTemplateCache(ttl time.Duration) *TemplateCache {
+	return &TemplateCache{
+		ttl:     ttl,
+		now:     time.Now,
+		entries: make(map[templateCacheKey]templateCacheEntry),
+	}
+}
+
+// Get returns the cached ID of the template named name in zone, if not expired.
+func (c *TemplateCache) Get(zone, name string) (string, bool) {
+	if c == nil {
+		return "", false
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	key := templateCacheKey{zone: zone, name: name}
+	entry, ok := c.entries[key]
+	if !ok {
+		return "", false
+	}
+	if !c.now().Before(entry.expires) {
+		delete(c.entries, key)
+		return "", false
+	}
+
+	return entry.id, true
+}
+
+// Set caches the ID of the template named name in zone.
+func (c *TemplateCache) Set(zone, name, id string) {
+	if c == nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries[templateCacheKey{zone: zone, name: name}] = templateCacheEntry{
+		id:      id,
+		expires: c.now().Add(c.ttl),
+	}
+}
+
+// GetTemplateCache returns the template cache of the provider configuration,
+// or nil if none is configured.
+func GetTemplateCache(meta interface{}) *TemplateCache {
+	c := meta.(map[string]interface{})
+	if cache, ok := c["template_cache"]; ok {
+		return cache.(*TemplateCache)
+	}
+	return nil
+}
@@ -0,0 +1,38 @@
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTemplateCache(t *testing.T) {
+	now := time.Now()
+
+	cache := NewTemplateCache(time.Minute)
+	cache.now = func() time.Time { return now }
+
+	_, ok := cache.Get("ch-gva-2", "Linux Ubuntu 22.04 LTS 64-bit")
+	require.False(t, ok)
+
+	cache.Set("ch-gva-2", "Linux Ubuntu 22.04 LTS 64-bit", "gva-id")
+	cache.Set("de-fra-1", "Linux Ubuntu 22.04 LTS 64-bit", "fra-id")
+
+	id, ok := cache.Get("ch-gva-2", "Linux Ubuntu 22.04 LTS 64-bit")
+	require.True(t, ok)
+	require.Equal(t, "gva-id", id)
+
+	id, ok = cache.Get("de-fra-1", "Linux Ubuntu 22.04 LTS 64-bit")
+	require.True(t, ok)
+	require.Equal(t, "fra-id", id, "expected resolutions scoped by zone")
+
+	now = now.Add(time.Minute)
+	_, ok = cache.Get("ch-gva-2", "Linux Ubuntu 22.04 LTS 64-bit")
+	require.False(t, ok, "expected expired resolution")
+
+	var disabled *TemplateCache
+	disabled.Set("ch-gva-2", "Linux Ubuntu 22.04 LTS 64-bit", "gva-id")
+	_, ok = disabled.Get("ch-gva-2", "Linux Ubuntu 22.04 LTS 64-bit")
+	require.False(t, ok)
+}
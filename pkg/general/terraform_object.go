@@ -47,6 +47,7 @@ func AddAttributes(res *schema.Resource, resourceSchema map[string]*schema.Schem
 			*newSchema = *attributeValue
 			newSchema.Required = false
 			newSchema.Optional = true
+			newSchema.ForceNew = false
 			newSchema.Default = nil
 
 			res.Schema[attributeIdentifier] = newSchema
@@ -0,0 +1,102 @@
+package config
+
+import (
+	"sync"
+)
+
+// ListCache caches the responses of API list operations, keyed by operation and zone,
+// so that identical list calls issued by several data sources are only served once.
+// A ListCache lives as long as the provider configuration, i.e. a single Terraform run.
+// A nil *ListCache is valid and disables caching.
+type ListCache struct {
+	mu      sync.Mutex
+	entries map[listCacheKey]*listCacheEntry
+}
+
+type listCacheKey struct {
+	op   string
+	zone string
+}
+
+type listCacheEntry struct {
+	once  sync.Once
+	value interface{}
+	err   error
+}
+
+// NewListCache returns an empty ListCache if enabled is true, or nil otherwise.
+func NewListCache(enabled bool) *ListCache {
+	if !enabled {
+		return nil
+	}
+
+	return &ListCache{entries: make(map[listCacheKey]*listCacheEntry)}
+}
+
+var (
+	listCaches   = make(map[string]*ListCache)
+	listCachesMu sync.Mutex
+)
+
+// SharedListCache returns the ListCache of the requests issued with the API key if
+// enabled is true, or nil otherwise. The SDK and framework providers being served by
+// the same process, they share the same cache so that the resources changes performed
+// by either of them invalidate the list responses cached for both.
+func SharedListCache(key string, enabled bool) *ListCache {
+	if !enabled {
+		return nil
+	}
+
+	listCachesMu.Lock()
+	defer listCachesMu.Unlock()
+
+	if _, ok := listCaches[key]; !ok {
+		listCaches[key] = NewListCache(true)
+	}
+
+	return listCaches[key]
+}
+
+// Get returns the cached response of the operation op in zone, calling fetch
+// to retrieve it if not cached yet. Concurrent calls for the same key share
+// a single fetch, and failed fetches are not cached.
+func (c *ListCache) Get(op, zone string, fetch func() (interface{}, error)) (interface{}, error) {
+	if c == nil {
+		return fetch()
+	}
+
+	key := listCacheKey{op: op, zone: zone}
+
+	c.mu.Lock()
+	entry, ok := c.entries[key]
+	if !ok {
+		entry = &listCacheEntry{}
+		c.entries[key] = entry
+	}
+	c.mu.Unlock()
+
+	entry.once.Do(func() {
+		entry.value, entry.err = fetch()
+	})
+
+	if entry.err != nil {
+		c.mu.Lock()
+		if c.entries[key] == entry {
+			delete(c.entries, key)
+		}
+		c.mu.Unlock()
+	}
+
+	return entry.value, entry.err
+}
+
+// Reset drops all the cached responses.
+func (c *ListCache) Reset() {
+	if c == nil {
+		return
+	}
+
+	c.mu.Lock()
+	c.entries = make(map[listCacheKey]*listCacheEntry)
+	c.mu.Unlock()
+}
@@ -0,0 +1,69 @@
+package config
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestListCache(t *testing.T) {
+	calls := 0
+	fetch := func() (interface{}, error) {
+		calls++
+		return calls, nil
+	}
+
+	cache := NewListCache(true)
+
+	v, err := cache.Get("ListNetworks", "ch-gva-2", fetch)
+	require.NoError(t, err)
+	require.Equal(t, 1, v)
+
+	v, err = cache.Get("ListNetworks", "ch-gva-2", fetch)
+	require.NoError(t, err)
+	require.Equal(t, 1, v, "expected the cached response")
+
+	v, err = cache.Get("ListNetworks", "de-fra-1", fetch)
+	require.NoError(t, err)
+	require.Equal(t, 2, v, "expected a response per zone")
+
+	cache.Reset()
+	v, err = cache.Get("ListNetworks", "ch-gva-2", fetch)
+	require.NoError(t, err)
+	require.Equal(t, 3, v, "expected a fresh response after reset")
+
+	_, err = cache.Get("ListElasticIPs", "ch-gva-2", func() (interface{}, error) {
+		return nil, errors.New("boom")
+	})
+	require.Error(t, err)
+	v, err = cache.Get("ListElasticIPs", "ch-gva-2", fetch)
+	require.NoError(t, err)
+	require.Equal(t, 4, v, "expected errors not to be cached")
+}
+
+func TestSharedListCache(t *testing.T) {
+	require.Nil(t, SharedListCache("EXOtest", false))
+
+	cache := SharedListCache("EXOtest", true)
+	require.NotNil(t, cache)
+	require.Same(t, cache, SharedListCache("EXOtest", true))
+	require.NotSame(t, cache, SharedListCache("EXOother", true))
+}
+
+func TestListCacheDisabled(t *testing.T) {
+	calls := 0
+	fetch := func() (interface{}, error) {
+		calls++
+		return calls, nil
+	}
+
+	cache := NewListCache(false)
+	require.Nil(t, cache)
+
+	_, _ = cache.Get("ListNetworks", "ch-gva-2", fetch)
+	_, _ = cache.Get("ListNetworks", "ch-gva-2", fetch)
+	require.Equal(t, 2, calls)
+
+	cache.Reset()
+}
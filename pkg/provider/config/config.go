@@ -1,8 +1,10 @@
 package config
 
 import (
+	"fmt"
 	"os"
 	"strconv"
+	"sync"
 	"time"
 
 	"github.com/exoscale/egoscale"
@@ -12,6 +14,10 @@ import (
 	"github.com/exoscale/terraform-provider-exoscale/pkg/config"
 )
 
+// DefaultDNSMaxRetries represents the default number of times a DNS API request
+// is retried upon server-side (5xx) or rate-limiting (429) errors.
+const DefaultDNSMaxRetries = 4
+
 // BaseConfig represents the provider structure
 type BaseConfig struct {
 	Key             string
@@ -20,8 +26,23 @@ type BaseConfig struct {
 	ComputeEndpoint string
 	DNSEndpoint     string
 	Environment     string
+	DefaultZone     string
+	MaxConcurrency  int
+	DNSMaxRetries   int
+	QuotaChecks     bool
 	ComputeClient   *egoscale.Client
 	DNSClient       *egoscale.Client
+
+	// APISemaphore bounds the number of concurrent API requests issued by
+	// all the clients built from this configuration (nil means unbounded).
+	APISemaphore chan struct{}
+
+	// ListCache caches the list operations responses of data sources
+	// (nil means disabled).
+	ListCache *ListCache
+
+	// ReadOnly makes the clients refuse any request which may modify resources.
+	ReadOnly bool
 }
 
 type ExoscaleProviderConfig struct {
@@ -64,3 +85,98 @@ func GetTimeout() (float64, error) {
 
 	return defaultTimeout, nil
 }
+
+func GetMaxConcurrency() (int, error) {
+	maxConcurrencyRaw := GetEnvDefault("EXOSCALE_MAX_CONCURRENCY", "")
+	if maxConcurrencyRaw != "" {
+		maxConcurrency, err := strconv.Atoi(maxConcurrencyRaw)
+		if err != nil {
+			return 0, err
+		}
+		if maxConcurrency < 0 {
+			return 0, fmt.Errorf("invalid EXOSCALE_MAX_CONCURRENCY value %d: must be positive or 0", maxConcurrency)
+		}
+
+		return maxConcurrency, nil
+	}
+
+	return 0, nil
+}
+
+func GetDNSMaxRetries() (int, error) {
+	dnsMaxRetriesRaw := GetEnvDefault("EXOSCALE_DNS_MAX_RETRIES", "")
+	if dnsMaxRetriesRaw != "" {
+		return strconv.Atoi(dnsMaxRetriesRaw)
+	}
+
+	return DefaultDNSMaxRetries, nil
+}
+
+func GetEnableQuotaChecks() (bool, error) {
+	enableQuotaChecksRaw := GetEnvDefault("EXOSCALE_ENABLE_QUOTA_CHECKS", "")
+	if enableQuotaChecksRaw != "" {
+		return strconv.ParseBool(enableQuotaChecksRaw)
+	}
+
+	return false, nil
+}
+
+func GetEnableListCache() (bool, error) {
+	enableListCacheRaw := GetEnvDefault("EXOSCALE_ENABLE_LIST_CACHE", "")
+	if enableListCacheRaw != "" {
+		return strconv.ParseBool(enableListCacheRaw)
+	}
+
+	return false, nil
+}
+
+func GetReadOnly() (bool, error) {
+	readOnlyRaw := GetEnvDefault("EXOSCALE_READ_ONLY", "")
+	if readOnlyRaw != "" {
+		return strconv.ParseBool(readOnlyRaw)
+	}
+
+	return false, nil
+}
+
+// NewAPISemaphore returns a semaphore allowing up to n concurrent API
+// requests, or nil if n is not a positive number.
+func NewAPISemaphore(n int) chan struct{} {
+	if n <= 0 {
+		return nil
+	}
+
+	return make(chan struct{}, n)
+}
+
+// GetDefaultZone returns the provider default_zone used when it is not set in the
+// provider configuration, from the EXOSCALE_DEFAULT_ZONE environment variable
+// (defaulting to config.DefaultZone).
+func GetDefaultZone() string {
+	return GetEnvDefault("EXOSCALE_DEFAULT_ZONE", config.DefaultZone)
+}
+
+var (
+	apiSemaphores   = make(map[string]chan struct{})
+	apiSemaphoresMu sync.Mutex
+)
+
+// SharedAPISemaphore returns the semaphore allowing up to n concurrent API requests
+// issued with the API key, or nil if n is not a positive number. The SDK and framework
+// providers being served by the same process, they share the same semaphore so that
+// the limit applies to the requests of both.
+func SharedAPISemaphore(key string, n int) chan struct{} {
+	if n <= 0 {
+		return nil
+	}
+
+	apiSemaphoresMu.Lock()
+	defer apiSemaphoresMu.Unlock()
+
+	id := fmt.Sprintf("%s/%d", key, n)
+	if _, ok := apiSemaphores[id]; !ok {
+		apiSemaphores[id] = NewAPISemaphore(n)
+	}
+
+	return apiSemaphores[id]
+}
@@ -4,10 +4,12 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	exov2 "github.com/exoscale/egoscale/v2"
@@ -20,17 +22,24 @@ import (
 )
 
 const (
-	KeyAttrName             = "key"
-	TokenAttrName           = "token"
-	SecretAttrName          = "secret"
-	ConfigAttrName          = "config"
-	ProfileAttrName         = "profile"
-	RegionAttrName          = "region"
-	ComputeEndpointAttrName = "compute_endpoint"
-	DnsEndpointAttrName     = "dns_endpoint"
-	EnvironmentAttrName     = "environment"
-	TimeoutAttrName         = "timeout"
-	DelayAttrName           = "delay"
+	KeyAttrName                            = "key"
+	TokenAttrName                          = "token"
+	SecretAttrName                         = "secret"
+	ConfigAttrName                         = "config"
+	ProfileAttrName                        = "profile"
+	RegionAttrName                         = "region"
+	ComputeEndpointAttrName                = "compute_endpoint"
+	DnsEndpointAttrName                    = "dns_endpoint"
+	EnvironmentAttrName                    = "environment"
+	DefaultZoneAttrName                    = "default_zone"
+	TimeoutAttrName                        = "timeout"
+	MaxConcurrencyAttrName                 = "max_concurrency"
+	DNSMaxRetriesAttrName                  = "dns_max_retries"
+	QuotaChecksAttrName                    = "enable_quota_checks"
+	ListCacheAttrName                      = "enable_list_cache"
+	ReadOnlyAttrName                       = "read_only"
+	NLBServiceHealthcheckDefaultsBlockName = "nlb_service_healthcheck_defaults"
+	DelayAttrName                          = "delay"
 )
 
 var _ provider.Provider = &ExoscaleProvider{}
@@ -38,17 +47,24 @@ var _ provider.Provider = &ExoscaleProvider{}
 type ExoscaleProvider struct{}
 
 type ExoscaleProviderModel struct {
-	Key             types.String  `tfsdk:"key"`
-	Token           types.String  `tfsdk:"token"`
-	Secret          types.String  `tfsdk:"secret"`
-	Config          types.String  `tfsdk:"config"`
-	Profile         types.String  `tfsdk:"profile"`
-	Region          types.String  `tfsdk:"region"`
-	ComputeEndpoint types.String  `tfsdk:"compute_endpoint"`
-	DnsEndpoint     types.String  `tfsdk:"dns_endpoint"`
-	Environment     types.String  `tfsdk:"environment"`
-	Timeout         types.Float64 `tfsdk:"timeout"`
-	Delay           types.Int64   `tfsdk:"delay"`
+	Key                           types.String  `tfsdk:"key"`
+	Token                         types.String  `tfsdk:"token"`
+	Secret                        types.String  `tfsdk:"secret"`
+	Config                        types.String  `tfsdk:"config"`
+	Profile                       types.String  `tfsdk:"profile"`
+	Region                        types.String  `tfsdk:"region"`
+	ComputeEndpoint               types.String  `tfsdk:"compute_endpoint"`
+	DnsEndpoint                   types.String  `tfsdk:"dns_endpoint"`
+	Environment                   types.String  `tfsdk:"environment"`
+	DefaultZone                   types.String  `tfsdk:"default_zone"`
+	Timeout                       types.Float64 `tfsdk:"timeout"`
+	MaxConcurrency                types.Int64   `tfsdk:"max_concurrency"`
+	DNSMaxRetries                 types.Int64   `tfsdk:"dns_max_retries"`
+	QuotaChecks                   types.Bool    `tfsdk:"enable_quota_checks"`
+	ListCache                     types.Bool    `tfsdk:"enable_list_cache"`
+	ReadOnly                      types.Bool    `tfsdk:"read_only"`
+	NLBServiceHealthcheckDefaults types.List    `tfsdk:"nlb_service_healthcheck_defaults"`
+	Delay                         types.Int64   `tfsdk:"delay"`
 }
 
 func (p *ExoscaleProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -93,6 +109,16 @@ func (p *ExoscaleProvider) Schema(ctx context.Context, req provider.SchemaReques
 			},
 			EnvironmentAttrName: schema.StringAttribute{
 				Optional: true,
+				MarkdownDescription: fmt.Sprintf(
+					"Exoscale API environment, used to build the zonal API endpoints (by default: %s)",
+					exoscale.DefaultEnvironment),
+			},
+			DefaultZoneAttrName: schema.StringAttribute{
+				Optional: true,
+				MarkdownDescription: fmt.Sprintf(
+					"Zone used for zone-agnostic operations such as DNS or global resources (Security Groups, SSH keys, "+
+						"Anti-Affinity Groups, IAM) management (by default: %s)",
+					config.DefaultZone),
 			},
 			TimeoutAttrName: schema.Float64Attribute{
 				Optional: true,
@@ -100,11 +126,81 @@ func (p *ExoscaleProvider) Schema(ctx context.Context, req provider.SchemaReques
 					"Timeout in seconds for waiting on compute resources to become available (by default: %.0f)",
 					config.DefaultTimeout.Seconds()),
 			},
+			MaxConcurrencyAttrName: schema.Int64Attribute{
+				Optional: true,
+				MarkdownDescription: "Maximum number of concurrent API requests issued by the provider, " +
+					"regardless of Terraform parallelism (by default: unlimited)",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			DNSMaxRetriesAttrName: schema.Int64Attribute{
+				Optional: true,
+				MarkdownDescription: fmt.Sprintf(
+					"Maximum number of retries of DNS API requests failing with a server or rate-limiting error (by default: %d)",
+					providerConfig.DefaultDNSMaxRetries),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			QuotaChecksAttrName: schema.BoolAttribute{
+				Optional: true,
+				MarkdownDescription: "Check the organization quotas when planning the creation of compute instances (including " +
+					"instance pools members), private networks and Elastic IPs, failing at plan time with an explicit " +
+					"error if a quota is exhausted. Each resource is checked on its own: the quota required by several " +
+					"resources planned together (e.g. using `count`) is not summed, and may still be exhausted at apply " +
+					"time (by default: false)",
+			},
+			ListCacheAttrName: schema.BoolAttribute{
+				Optional: true,
+				MarkdownDescription: "Cache the responses of the list API operations performed by data sources (e.g. networks, Elastic IPs, templates) " +
+					"for the duration of a Terraform run, any write request invalidating the cache (by default: false)",
+			},
+			ReadOnlyAttrName: schema.BoolAttribute{
+				Optional: true,
+				MarkdownDescription: "Refuse any API request which may modify resources, failing the create, update and delete operations " +
+					"with an explicit error while allowing reads (e.g. to safely run `terraform plan` against production; by default: false)",
+			},
 			DelayAttrName: schema.Int64Attribute{
 				Optional:           true,
 				DeprecationMessage: "Does nothing",
 			},
 		},
+		Blocks: map[string]schema.Block{
+			// Only used by the exoscale_nlb_service resource (SDK provider), which enforces a single block.
+			NLBServiceHealthcheckDefaultsBlockName: schema.ListNestedBlock{
+				MarkdownDescription: "Default healthcheck settings of the `exoscale_nlb_service` resources not declaring a `healthcheck` block " +
+					"(the healthcheck port being the service target port).",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"interval": schema.Int64Attribute{
+							Optional:            true,
+							MarkdownDescription: "The healthcheck interval in seconds (default: `10`).",
+						},
+						"mode": schema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: "The healthcheck mode (`tcp`|`http`|`https`; default: `tcp`).",
+						},
+						"retries": schema.Int64Attribute{
+							Optional:            true,
+							MarkdownDescription: "The healthcheck retries (default: `1`).",
+						},
+						"timeout": schema.Int64Attribute{
+							Optional:            true,
+							MarkdownDescription: "The healthcheck timeout (seconds; default: `5`).",
+						},
+						"tls_sni": schema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: "The healthcheck TLS SNI server name (only if `mode` is `https`).",
+						},
+						"uri": schema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: "The healthcheck URI (must be set only if `mode` is `http(s)`).",
+						},
+					},
+				},
+			},
+		},
 	}
 }
 
@@ -240,6 +336,13 @@ func (p *ExoscaleProvider) Configure(ctx context.Context, req provider.Configure
 		environment = data.Environment.ValueString()
 	}
 
+	var defaultZone string
+	if data.DefaultZone.IsNull() {
+		defaultZone = providerConfig.GetDefaultZone()
+	} else {
+		defaultZone = data.DefaultZone.ValueString()
+	}
+
 	var timeout float64
 	if data.Timeout.IsNull() {
 		var err error
@@ -252,6 +355,66 @@ func (p *ExoscaleProvider) Configure(ctx context.Context, req provider.Configure
 		timeout = data.Timeout.ValueFloat64()
 	}
 
+	var maxConcurrency int
+	if data.MaxConcurrency.IsNull() {
+		var err error
+		maxConcurrency, err = providerConfig.GetMaxConcurrency()
+
+		if err != nil {
+			resp.Diagnostics.AddError(err.Error(), "")
+		}
+	} else {
+		maxConcurrency = int(data.MaxConcurrency.ValueInt64())
+	}
+
+	var dnsMaxRetries int
+	if data.DNSMaxRetries.IsNull() {
+		var err error
+		dnsMaxRetries, err = providerConfig.GetDNSMaxRetries()
+
+		if err != nil {
+			resp.Diagnostics.AddError(err.Error(), "")
+		}
+	} else {
+		dnsMaxRetries = int(data.DNSMaxRetries.ValueInt64())
+	}
+
+	var enableQuotaChecks bool
+	if data.QuotaChecks.IsNull() {
+		var err error
+		enableQuotaChecks, err = providerConfig.GetEnableQuotaChecks()
+
+		if err != nil {
+			resp.Diagnostics.AddError(err.Error(), "")
+		}
+	} else {
+		enableQuotaChecks = data.QuotaChecks.ValueBool()
+	}
+
+	var enableListCache bool
+	if data.ListCache.IsNull() {
+		var err error
+		enableListCache, err = providerConfig.GetEnableListCache()
+
+		if err != nil {
+			resp.Diagnostics.AddError(err.Error(), "")
+		}
+	} else {
+		enableListCache = data.ListCache.ValueBool()
+	}
+
+	var readOnly bool
+	if data.ReadOnly.IsNull() {
+		var err error
+		readOnly, err = providerConfig.GetReadOnly()
+
+		if err != nil {
+			resp.Diagnostics.AddError(err.Error(), "")
+		}
+	} else {
+		readOnly = data.ReadOnly.ValueBool()
+	}
+
 	exov2.UserAgent = exoscale.UserAgent
 
 	baseConfig := providerConfig.BaseConfig{
@@ -261,6 +424,13 @@ func (p *ExoscaleProvider) Configure(ctx context.Context, req provider.Configure
 		ComputeEndpoint: endpoint,
 		DNSEndpoint:     dnsEndpoint,
 		Environment:     environment,
+		DefaultZone:     defaultZone,
+		MaxConcurrency:  maxConcurrency,
+		DNSMaxRetries:   dnsMaxRetries,
+		QuotaChecks:     enableQuotaChecks,
+		APISemaphore:    providerConfig.SharedAPISemaphore(key, maxConcurrency),
+		ListCache:       providerConfig.SharedListCache(key, enableListCache),
+		ReadOnly:        readOnly,
 	}
 
 	clv1 := exoscale.GetComputeClient(map[string]interface{}{
@@ -297,12 +467,19 @@ func (p *ExoscaleProvider) DataSources(ctx context.Context) []func() datasource.
 			return &zones.ZonesDataSource{}
 		},
 		database.NewDataSourceURI,
+		database.NewDataSourcePlans,
+		database.NewDataSourceSettings,
+		zones.NewDataSourceZone,
 	}
 }
 
 func (p *ExoscaleProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		database.NewResource,
+		database.NewResourceACL,
+		database.NewResourceConnectionPool,
+		database.NewResourceIntegration,
+		database.NewResourceUser,
 	}
 }
 
@@ -1,10 +1,13 @@
 package anti_affinity_group
 
 const (
-	Name = "exoscale_anti_affinity_group"
+	Name        = "exoscale_anti_affinity_group"
+	NameMembers = "exoscale_anti_affinity_group_members"
 
 	AttrID          = "id"
 	AttrDescription = "description"
 	AttrInstances   = "instances"
+	AttrMembers     = "members"
 	AttrName        = "name"
+	AttrZone        = "zone"
 )
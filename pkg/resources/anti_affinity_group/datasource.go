@@ -49,7 +49,7 @@ func dsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.
 		"id": utils.IDString(d, Name),
 	})
 
-	zone := config.DefaultZone
+	zone := config.GetDefaultZone(meta)
 
 	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutRead))
 	ctx = exoapi.WithEndpoint(ctx, exoapi.NewReqEndpoint(config.GetEnvironment(meta), zone))
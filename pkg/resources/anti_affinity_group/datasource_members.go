@@ -0,0 +1,174 @@
+package anti_affinity_group
+
+import (
+	"context"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	exoapi "github.com/exoscale/egoscale/v2/api"
+
+	"github.com/exoscale/terraform-provider-exoscale/pkg/config"
+	"github.com/exoscale/terraform-provider-exoscale/pkg/utils"
+)
+
+func DataSourceMembers() *schema.Resource {
+	return &schema.Resource{
+		Description: `List the members of an Exoscale [Anti-Affinity Group](https://community.exoscale.com/documentation/compute/anti-affinity-groups/), with their names and zones.
+
+Corresponding resource: [exoscale_anti_affinity_group](../resources/anti_affinity_group.md).`,
+		Schema: map[string]*schema.Schema{
+			AttrID: {
+				Description:   "The anti-affinity group ID to match (conflicts with `name`).",
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{AttrName},
+			},
+			AttrMembers: {
+				Description: "The list of attached [exoscale_compute_instance](../resources/compute_instance.md), sorted by zone and name.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						AttrID: {
+							Description: "The compute instance ID.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						AttrName: {
+							Description: "The compute instance name (empty if the instance could not be found).",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						AttrZone: {
+							Description: "The compute instance zone (empty if the instance could not be found).",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+			AttrName: {
+				Description:   "The group name to match (conflicts with `id`).",
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{AttrID},
+			},
+		},
+
+		ReadContext: dsMembersRead,
+	}
+}
+
+func dsMembersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tflog.Debug(ctx, "beginning read", map[string]interface{}{
+		"id": utils.IDString(d, NameMembers),
+	})
+
+	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutRead))
+	defer cancel()
+
+	client, err := config.GetClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id, byID := d.GetOk(AttrID)
+	name, byName := d.GetOk(AttrName)
+	if !byID && !byName {
+		return diag.Errorf(
+			"either %s or %s must be specified",
+			AttrName,
+			AttrID,
+		)
+	}
+
+	defaultZone := config.GetDefaultZone(meta)
+	res, err := client.FindAntiAffinityGroup(
+		exoapi.WithEndpoint(ctx, exoapi.NewReqEndpoint(config.GetEnvironment(meta), defaultZone)),
+		defaultZone,
+		func() string {
+			if byID {
+				return id.(string)
+			}
+			return name.(string)
+		}(),
+	)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var instanceIDs []string
+	if res.InstanceIDs != nil {
+		instanceIDs = *res.InstanceIDs
+	}
+
+	// Anti-affinity groups are global while instances are zonal: look for
+	// the members among the instances of every zone, until all are found.
+	names := make(map[string]string, len(instanceIDs))
+	zones := make(map[string]string, len(instanceIDs))
+	for _, zone := range config.Zones {
+		if len(zones) == len(instanceIDs) {
+			break
+		}
+
+		instances, err := client.ListInstances(
+			exoapi.WithEndpoint(ctx, exoapi.NewReqEndpoint(config.GetEnvironment(meta), zone)),
+			zone,
+		)
+		if err != nil {
+			return diag.Errorf("unable to list instances in zone %q: %v", zone, err)
+		}
+
+		for _, instance := range instances {
+			if instance.ID == nil || !utils.In(instanceIDs, *instance.ID) {
+				continue
+			}
+			names[*instance.ID] = utils.DefaultString(instance.Name, "")
+			zones[*instance.ID] = zone
+		}
+	}
+
+	d.SetId(*res.ID)
+
+	if err := d.Set(AttrMembers, dsMembersData(instanceIDs, names, zones)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(AttrName, *res.Name); err != nil {
+		return diag.FromErr(err)
+	}
+
+	tflog.Debug(ctx, "read finished successfully", map[string]interface{}{
+		"id": utils.IDString(d, NameMembers),
+	})
+
+	return nil
+}
+
+// dsMembersData returns the members data sorted by zone, name and ID, the members
+// missing from names and zones (i.e. not found) being reported with their ID only.
+func dsMembersData(instanceIDs []string, names, zones map[string]string) []interface{} {
+	data := make([]interface{}, 0, len(instanceIDs))
+	for _, id := range instanceIDs {
+		data = append(data, map[string]interface{}{
+			AttrID:   id,
+			AttrName: names[id],
+			AttrZone: zones[id],
+		})
+	}
+
+	sort.SliceStable(data, func(i, j int) bool {
+		a, b := data[i].(map[string]interface{}), data[j].(map[string]interface{})
+		for _, attr := range []string{AttrZone, AttrName, AttrID} {
+			if a[attr] != b[attr] {
+				return a[attr].(string) < b[attr].(string)
+			}
+		}
+		return false
+	})
+
+	return data
+}
@@ -0,0 +1,29 @@
+package anti_affinity_group
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_dsMembersData(t *testing.T) {
+	var (
+		id1 = "2c5a2b0c-7a51-4a2e-9e0b-3f1c6d0f8a01"
+		id2 = "9b7e1c4d-2f36-4d8e-8a5b-6c0e2d1f4b02"
+		id3 = "d41f8e2a-5c73-4b9f-a1d6-8e3b7c2a5f03"
+		id4 = "0a3c6e9f-1b24-4c5d-b7e8-f9a0b1c2d304"
+	)
+
+	data := dsMembersData(
+		[]string{id1, id2, id3, id4},
+		map[string]string{id1: "web-2", id2: "web-1", id3: "db-1"},
+		map[string]string{id1: "ch-gva-2", id2: "ch-gva-2", id3: "at-vie-1"},
+	)
+
+	require.Equal(t, []interface{}{
+		map[string]interface{}{AttrID: id4, AttrName: "", AttrZone: ""},
+		map[string]interface{}{AttrID: id3, AttrName: "db-1", AttrZone: "at-vie-1"},
+		map[string]interface{}{AttrID: id2, AttrName: "web-1", AttrZone: "ch-gva-2"},
+		map[string]interface{}{AttrID: id1, AttrName: "web-2", AttrZone: "ch-gva-2"},
+	}, data)
+}
@@ -0,0 +1,68 @@
+package anti_affinity_group_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	aagroup "github.com/exoscale/terraform-provider-exoscale/pkg/resources/anti_affinity_group"
+	"github.com/exoscale/terraform-provider-exoscale/pkg/testutils"
+)
+
+var (
+	dsMembersGroupName    = acctest.RandomWithPrefix(testutils.Prefix)
+	dsMembersInstanceName = acctest.RandomWithPrefix(testutils.Prefix)
+)
+
+func testDataSourceMembers(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testutils.AccPreCheck(t) },
+		ProviderFactories: testutils.Providers(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "exoscale_anti_affinity_group" "test" {
+  name = "%s"
+}
+
+data "exoscale_compute_template" "test" {
+  zone = "%s"
+  name = "%s"
+}
+
+resource "exoscale_compute_instance" "test" {
+  zone                    = "%s"
+  name                    = "%s"
+  type                    = "standard.tiny"
+  template_id             = data.exoscale_compute_template.test.id
+  disk_size               = 10
+  anti_affinity_group_ids = [exoscale_anti_affinity_group.test.id]
+}
+
+data "exoscale_anti_affinity_group_members" "test" {
+  name       = exoscale_anti_affinity_group.test.name
+  depends_on = [exoscale_compute_instance.test]
+}`,
+					dsMembersGroupName,
+					testutils.TestZoneName,
+					testutils.TestInstanceTemplateName,
+					testutils.TestZoneName,
+					dsMembersInstanceName,
+				),
+				Check: resource.ComposeTestCheckFunc(
+					dsTestAttributes("data."+aagroup.NameMembers+".test", testutils.TestAttrs{
+						aagroup.AttrID:                                 validation.ToDiagFunc(validation.IsUUID),
+						aagroup.AttrMembers + ".#":                     testutils.ValidateString("1"),
+						aagroup.AttrMembers + ".0." + aagroup.AttrID:   validation.ToDiagFunc(validation.IsUUID),
+						aagroup.AttrMembers + ".0." + aagroup.AttrName: testutils.ValidateString(dsMembersInstanceName),
+						aagroup.AttrMembers + ".0." + aagroup.AttrZone: testutils.ValidateString(testutils.TestZoneName),
+						aagroup.AttrName:                               testutils.ValidateString(dsMembersGroupName),
+					}),
+				),
+			},
+		},
+	})
+}
@@ -4,5 +4,6 @@ import "testing"
 
 func TestAntiAffinityGroup(t *testing.T) {
 	t.Run("DataSource", testDataSource)
+	t.Run("DataSourceMembers", testDataSourceMembers)
 	t.Run("Resource", testResource)
 }
@@ -56,7 +56,7 @@ func rCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag
 		"id": utils.IDString(d, Name),
 	})
 
-	zone := config.DefaultZone
+	zone := config.GetDefaultZone(meta)
 
 	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutCreate))
 	ctx = exoapi.WithEndpoint(ctx, exoapi.NewReqEndpoint(config.GetEnvironment(meta), zone))
@@ -89,7 +89,7 @@ func rRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.D
 		"id": utils.IDString(d, Name),
 	})
 
-	zone := config.DefaultZone
+	zone := config.GetDefaultZone(meta)
 
 	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutRead))
 	ctx = exoapi.WithEndpoint(ctx, exoapi.NewReqEndpoint(config.GetEnvironment(meta), zone))
@@ -122,7 +122,7 @@ func rDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag
 		"id": utils.IDString(d, Name),
 	})
 
-	zone := config.DefaultZone
+	zone := config.GetDefaultZone(meta)
 
 	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutDelete))
 	ctx = exoapi.WithEndpoint(ctx, exoapi.NewReqEndpoint(config.GetEnvironment(meta), zone))
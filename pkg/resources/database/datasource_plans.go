@@ -0,0 +1,242 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	exoscale "github.com/exoscale/egoscale/v2"
+	exoapi "github.com/exoscale/egoscale/v2/api"
+	"github.com/exoscale/egoscale/v2/oapi"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/exoscale/terraform-provider-exoscale/pkg/config"
+	providerConfig "github.com/exoscale/terraform-provider-exoscale/pkg/provider/config"
+)
+
+const DataSourcePlansDescription = `Fetch the plans available for an Exoscale [Database](https://community.exoscale.com/documentation/dbaas/) service type.
+
+Corresponding resource: [exoscale_database](../resources/database.md).`
+
+var _ datasource.DataSourceWithConfigure = &DataSourcePlans{}
+
+func NewDataSourcePlans() datasource.DataSource {
+	return &DataSourcePlans{}
+}
+
+type DataSourcePlans struct {
+	client *exoscale.Client
+	env    string
+}
+
+type DataSourcePlansModel struct {
+	Id    types.String          `tfsdk:"id"`
+	Name  types.String          `tfsdk:"name"`
+	Plans []DataSourcePlansPlan `tfsdk:"plans"`
+	Type  types.String          `tfsdk:"type"`
+	Zone  types.String          `tfsdk:"zone"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+type DataSourcePlansPlan struct {
+	DiskSpace  types.Int64  `tfsdk:"disk_space"`
+	Name       types.String `tfsdk:"name"`
+	NodeCPUs   types.Int64  `tfsdk:"node_cpus"`
+	NodeMemory types.Int64  `tfsdk:"node_memory"`
+	Nodes      types.Int64  `tfsdk:"nodes"`
+}
+
+func (d *DataSourcePlans) Metadata(
+	ctx context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_database_plans"
+}
+
+func (d *DataSourcePlans) Schema(
+	ctx context.Context,
+	req datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: DataSourcePlansDescription,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of this resource.",
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "A plan name to match: if set, fail unless the plan is available in the zone and only return this plan.",
+				Optional:            true,
+			},
+			"plans": schema.ListNestedAttribute{
+				MarkdownDescription: "The list of available plans, sorted by increasing size (nodes, then memory, CPUs and disk space per node).",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"disk_space": schema.Int64Attribute{
+							MarkdownDescription: "The disk space (bytes).",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The plan name.",
+							Computed:            true,
+						},
+						"node_cpus": schema.Int64Attribute{
+							MarkdownDescription: "The number of CPUs per node.",
+							Computed:            true,
+						},
+						"node_memory": schema.Int64Attribute{
+							MarkdownDescription: "The memory per node (bytes).",
+							Computed:            true,
+						},
+						"nodes": schema.Int64Attribute{
+							MarkdownDescription: "The number of nodes.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "The type of the database service (`grafana`, `kafka`, `mysql`, `opensearch`, `pg`, `redis`).",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(ServicesList...),
+				},
+			},
+			"zone": schema.StringAttribute{
+				MarkdownDescription: "The Exoscale Zone name.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(config.Zones...),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Read: true,
+			}),
+		},
+	}
+}
+
+func (d *DataSourcePlans) Configure(
+	ctx context.Context,
+	req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*providerConfig.ExoscaleProviderConfig).ClientV2
+	d.env = req.ProviderData.(*providerConfig.ExoscaleProviderConfig).Environment
+}
+
+func (d *DataSourcePlans) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data DataSourcePlansModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set timeout
+	t, diags := data.Timeouts.Read(ctx, config.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, t)
+	defer cancel()
+
+	ctx = exoapi.WithEndpoint(ctx, exoapi.NewReqEndpoint(d.env, data.Zone.ValueString()))
+
+	res, err := d.client.GetDbaasServiceTypeWithResponse(ctx, data.Type.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read Database Service type %s: %s", data.Type.ValueString(), err))
+		return
+	}
+	if res.StatusCode() != http.StatusOK {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read Database Service type %s, unexpected status: %s", data.Type.ValueString(), res.Status()))
+		return
+	}
+
+	var plans []oapi.DbaasPlan
+	if res.JSON200.Plans != nil {
+		plans = *res.JSON200.Plans
+	}
+
+	data.Plans, err = dataSourcePlansFilter(plans, data.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("name"),
+			"Plan not available",
+			fmt.Sprintf("%s in zone %s", err, data.Zone.ValueString()),
+		)
+		return
+	}
+
+	data.Id = types.StringValue(data.Zone.ValueString() + "/" + data.Type.ValueString())
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// dataSourcePlansFilter returns the authorized plans sorted by increasing size, or only the plan
+// named name if not empty, failing if no such plan is available.
+func dataSourcePlansFilter(plans []oapi.DbaasPlan, name string) ([]DataSourcePlansPlan, error) {
+	available := make([]string, 0, len(plans))
+	list := make([]DataSourcePlansPlan, 0, len(plans))
+
+	for _, plan := range plans {
+		if plan.Name == nil || (plan.Authorized != nil && !*plan.Authorized) {
+			continue
+		}
+		available = append(available, *plan.Name)
+
+		if name != "" && *plan.Name != name {
+			continue
+		}
+
+		list = append(list, DataSourcePlansPlan{
+			DiskSpace:  types.Int64PointerValue(plan.DiskSpace),
+			Name:       types.StringPointerValue(plan.Name),
+			NodeCPUs:   types.Int64PointerValue(plan.NodeCpuCount),
+			NodeMemory: types.Int64PointerValue(plan.NodeMemory),
+			Nodes:      types.Int64PointerValue(plan.NodeCount),
+		})
+	}
+
+	// Sort the plans by increasing size, so that the smallest plan meeting
+	// some requirements is the first one matching them.
+	sort.SliceStable(list, func(i, j int) bool {
+		a, b := list[i], list[j]
+		switch {
+		case a.Nodes.ValueInt64() != b.Nodes.ValueInt64():
+			return a.Nodes.ValueInt64() < b.Nodes.ValueInt64()
+		case a.NodeMemory.ValueInt64() != b.NodeMemory.ValueInt64():
+			return a.NodeMemory.ValueInt64() < b.NodeMemory.ValueInt64()
+		case a.NodeCPUs.ValueInt64() != b.NodeCPUs.ValueInt64():
+			return a.NodeCPUs.ValueInt64() < b.NodeCPUs.ValueInt64()
+		default:
+			return a.DiskSpace.ValueInt64() < b.DiskSpace.ValueInt64()
+		}
+	})
+
+	if name != "" && len(list) == 0 {
+		return nil, fmt.Errorf("plan %q is not available, expected one of: %s", name, strings.Join(available, ", "))
+	}
+
+	return list, nil
+}
@@ -0,0 +1,51 @@
+package database
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/exoscale/egoscale/v2/oapi"
+)
+
+func Test_dataSourcePlansFilter(t *testing.T) {
+	plan := func(name string, authorized bool, nodes int64) oapi.DbaasPlan {
+		cpus, memory, disk := int64(2), int64(2<<30), int64(8<<30)
+		return oapi.DbaasPlan{
+			Authorized:   &authorized,
+			DiskSpace:    &disk,
+			Name:         &name,
+			NodeCount:    &nodes,
+			NodeCpuCount: &cpus,
+			NodeMemory:   &memory,
+		}
+	}
+
+	plans := []oapi.DbaasPlan{
+		plan("business-4", true, 2),
+		plan("hobbyist-2", true, 1),
+		plan("premium-225", false, 3),
+		{}, // plans lacking a name are ignored
+	}
+
+	names := func(list []DataSourcePlansPlan) []string {
+		out := make([]string, len(list))
+		for i, p := range list {
+			out[i] = p.Name.ValueString()
+		}
+		return out
+	}
+
+	list, err := dataSourcePlansFilter(plans, "")
+	require.NoError(t, err)
+	require.Equal(t, []string{"hobbyist-2", "business-4"}, names(list))
+	require.Equal(t, int64(2), list[0].NodeCPUs.ValueInt64())
+	require.Equal(t, int64(1), list[0].Nodes.ValueInt64())
+
+	list, err = dataSourcePlansFilter(plans, "business-4")
+	require.NoError(t, err)
+	require.Equal(t, []string{"business-4"}, names(list))
+
+	_, err = dataSourcePlansFilter(plans, "premium-225")
+	require.EqualError(t, err, `plan "premium-225" is not available, expected one of: business-4, hobbyist-2`)
+}
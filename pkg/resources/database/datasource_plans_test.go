@@ -0,0 +1,79 @@
+package database_test
+
+import (
+	"bytes"
+	"regexp"
+	"testing"
+	"text/template"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/exoscale/terraform-provider-exoscale/pkg/testutils"
+)
+
+type DataSourcePlansModel struct {
+	ResourceName string
+
+	Name string
+	Type string
+	Zone string
+}
+
+func testDataSourcePlans(t *testing.T) {
+	tpl, err := template.ParseFiles("testdata/datasource_plans.tmpl")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	config := func(data DataSourcePlansModel) string {
+		buf := &bytes.Buffer{}
+		if err := tpl.Execute(buf, &data); err != nil {
+			t.Fatal(err)
+		}
+		return buf.String()
+	}
+
+	fullResourceName := "data.exoscale_database_plans.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutils.AccPreCheck(t) },
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config(DataSourcePlansModel{
+					ResourceName: "test",
+					Type:         "pg",
+					Zone:         testutils.TestZoneName,
+				}),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(fullResourceName, "plans.0.name"),
+					resource.TestCheckResourceAttrSet(fullResourceName, "plans.0.nodes"),
+					resource.TestCheckResourceAttrSet(fullResourceName, "plans.0.disk_space"),
+					resource.TestCheckResourceAttrSet(fullResourceName, "plans.0.node_memory"),
+					resource.TestCheckResourceAttrSet(fullResourceName, "plans.0.node_cpus"),
+				),
+			},
+			{
+				Config: config(DataSourcePlansModel{
+					ResourceName: "test",
+					Name:         "hobbyist-2",
+					Type:         "pg",
+					Zone:         testutils.TestZoneName,
+				}),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(fullResourceName, "plans.#", "1"),
+					resource.TestCheckResourceAttr(fullResourceName, "plans.0.name", "hobbyist-2"),
+				),
+			},
+			{
+				Config: config(DataSourcePlansModel{
+					ResourceName: "test",
+					Name:         "nonexistent-plan",
+					Type:         "pg",
+					Zone:         testutils.TestZoneName,
+				}),
+				ExpectError: regexp.MustCompile(`plan "nonexistent-plan" is not available`),
+			},
+		},
+	})
+}
@@ -0,0 +1,220 @@
+package database
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	exoscale "github.com/exoscale/egoscale/v2"
+	exoapi "github.com/exoscale/egoscale/v2/api"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/exoscale/terraform-provider-exoscale/pkg/config"
+	providerConfig "github.com/exoscale/terraform-provider-exoscale/pkg/provider/config"
+)
+
+const DataSourceSettingsDescription = `Fetch the settings JSON schemas of an Exoscale [Database](https://community.exoscale.com/documentation/dbaas/) service type,
+allowing to validate the settings of a service before applying them.
+
+Corresponding resource: [exoscale_database](../resources/database.md).`
+
+var _ datasource.DataSourceWithConfigure = &DataSourceSettings{}
+
+func NewDataSourceSettings() datasource.DataSource {
+	return &DataSourceSettings{}
+}
+
+type DataSourceSettings struct {
+	client *exoscale.Client
+	env    string
+}
+
+type DataSourceSettingsModel struct {
+	Id      types.String      `tfsdk:"id"`
+	Schemas map[string]string `tfsdk:"schemas"`
+	Type    types.String      `tfsdk:"type"`
+	Zone    types.String      `tfsdk:"zone"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (d *DataSourceSettings) Metadata(
+	ctx context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_database_settings"
+}
+
+func (d *DataSourceSettings) Schema(
+	ctx context.Context,
+	req datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: DataSourceSettingsDescription,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of this resource.",
+				Computed:            true,
+			},
+			"schemas": schema.MapAttribute{
+				MarkdownDescription: "The settings JSON schemas, by settings group (e.g. `pg`, `pgbouncer` and `pglookout` for the `pg_settings`, `pgbouncer_settings` and `pglookout_settings` of a `pg` service).",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "The type of the database service (`grafana`, `kafka`, `mysql`, `opensearch`, `pg`, `redis`).",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(ServicesList...),
+				},
+			},
+			"zone": schema.StringAttribute{
+				MarkdownDescription: "The Exoscale Zone name.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(config.Zones...),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Read: true,
+			}),
+		},
+	}
+}
+
+func (d *DataSourceSettings) Configure(
+	ctx context.Context,
+	req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*providerConfig.ExoscaleProviderConfig).ClientV2
+	d.env = req.ProviderData.(*providerConfig.ExoscaleProviderConfig).Environment
+}
+
+func (d *DataSourceSettings) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data DataSourceSettingsModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set timeout
+	t, diags := data.Timeouts.Read(ctx, config.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, t)
+	defer cancel()
+
+	ctx = exoapi.WithEndpoint(ctx, exoapi.NewReqEndpoint(d.env, data.Zone.ValueString()))
+
+	body, err := d.getSettingsSchemas(ctx, data.Type.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read Database Service type %s settings schemas: %s", data.Type.ValueString(), err))
+		return
+	}
+
+	data.Schemas, err = dataSourceSettingsSchemas(body)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to decode Database Service type %s settings schemas: %s", data.Type.ValueString(), err))
+		return
+	}
+
+	data.Id = types.StringValue(data.Zone.ValueString() + "/" + data.Type.ValueString())
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// getSettingsSchemas returns the raw settings schemas response body of the service type.
+func (d *DataSourceSettings) getSettingsSchemas(ctx context.Context, serviceType string) ([]byte, error) {
+	switch serviceType {
+	case "grafana":
+		res, err := d.client.GetDbaasSettingsGrafanaWithResponse(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return settingsResponseBody(res, res.Body)
+	case "kafka":
+		res, err := d.client.GetDbaasSettingsKafkaWithResponse(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return settingsResponseBody(res, res.Body)
+	case "mysql":
+		res, err := d.client.GetDbaasSettingsMysqlWithResponse(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return settingsResponseBody(res, res.Body)
+	case "opensearch":
+		res, err := d.client.GetDbaasSettingsOpensearchWithResponse(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return settingsResponseBody(res, res.Body)
+	case "pg":
+		res, err := d.client.GetDbaasSettingsPgWithResponse(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return settingsResponseBody(res, res.Body)
+	case "redis":
+		res, err := d.client.GetDbaasSettingsRedisWithResponse(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return settingsResponseBody(res, res.Body)
+	default:
+		return nil, fmt.Errorf("unsupported service type %q", serviceType)
+	}
+}
+
+// settingsResponse represents the settings schemas responses of all the service types.
+type settingsResponse interface {
+	StatusCode() int
+	Status() string
+}
+
+// settingsResponseBody returns the body of a successful settings schemas response.
+func settingsResponseBody(res settingsResponse, body []byte) ([]byte, error) {
+	if res.StatusCode() != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status: %s", res.Status())
+	}
+
+	return body, nil
+}
+
+// dataSourceSettingsSchemas returns the JSON schemas of the settings groups found
+// in the settings schemas response body.
+func dataSourceSettingsSchemas(body []byte) (map[string]string, error) {
+	var res struct {
+		Settings map[string]json.RawMessage `json:"settings"`
+	}
+	if err := json.Unmarshal(body, &res); err != nil {
+		return nil, err
+	}
+
+	schemas := make(map[string]string, len(res.Settings))
+	for group, s := range res.Settings {
+		schemas[group] = string(s)
+	}
+
+	return schemas, nil
+}
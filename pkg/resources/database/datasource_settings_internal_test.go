@@ -0,0 +1,27 @@
+package database
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_dataSourceSettingsSchemas(t *testing.T) {
+	schemas, err := dataSourceSettingsSchemas([]byte(`{
+  "settings": {
+    "pg": {"type": "object", "properties": {"max_connections": {"type": "integer"}}},
+    "pgbouncer": {"type": "object", "properties": {}}
+  }
+}`))
+	require.NoError(t, err)
+	require.Len(t, schemas, 2)
+	require.JSONEq(t, `{"type": "object", "properties": {"max_connections": {"type": "integer"}}}`, schemas["pg"])
+	require.JSONEq(t, `{"type": "object", "properties": {}}`, schemas["pgbouncer"])
+
+	schemas, err = dataSourceSettingsSchemas([]byte(`{}`))
+	require.NoError(t, err)
+	require.Empty(t, schemas)
+
+	_, err = dataSourceSettingsSchemas([]byte(`not json`))
+	require.Error(t, err)
+}
@@ -0,0 +1,54 @@
+package database_test
+
+import (
+	"bytes"
+	"testing"
+	"text/template"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/exoscale/terraform-provider-exoscale/pkg/testutils"
+)
+
+type DataSourceSettingsModel struct {
+	ResourceName string
+
+	Type string
+	Zone string
+}
+
+func testDataSourceSettings(t *testing.T) {
+	tpl, err := template.ParseFiles("testdata/datasource_settings.tmpl")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	config := func(data DataSourceSettingsModel) string {
+		buf := &bytes.Buffer{}
+		if err := tpl.Execute(buf, &data); err != nil {
+			t.Fatal(err)
+		}
+		return buf.String()
+	}
+
+	fullResourceName := "data.exoscale_database_settings.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutils.AccPreCheck(t) },
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config(DataSourceSettingsModel{
+					ResourceName: "test",
+					Type:         "pg",
+					Zone:         testutils.TestZoneName,
+				}),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(fullResourceName, "schemas.pg"),
+					resource.TestCheckResourceAttrSet(fullResourceName, "schemas.pgbouncer"),
+					resource.TestCheckResourceAttrSet(fullResourceName, "schemas.pglookout"),
+				),
+			},
+		},
+	})
+}
@@ -22,7 +22,13 @@ func TestDatabase(t *testing.T) {
 	t.Run("ResourceKafka", testResourceKafka)
 	t.Run("ResourceOpensearch", testResourceOpensearch)
 	t.Run("ResourceGrafana", testResourceGrafana)
+	t.Run("ResourceUser", testResourceUser)
+	t.Run("ResourceACL", testResourceACL)
+	t.Run("ResourceConnectionPool", testResourceConnectionPool)
+	t.Run("ResourceIntegration", testResourceIntegration)
 	t.Run("DataSourceURI", testDataSourceURI)
+	t.Run("DataSourcePlans", testDataSourcePlans)
+	t.Run("DataSourceSettings", testDataSourceSettings)
 }
 
 func CheckDestroy(dbType, name string) resource.TestCheckFunc {
@@ -0,0 +1,435 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	exoscale "github.com/exoscale/egoscale/v2"
+	exoapi "github.com/exoscale/egoscale/v2/api"
+	"github.com/exoscale/egoscale/v2/oapi"
+
+	"github.com/exoscale/terraform-provider-exoscale/pkg/config"
+	providerConfig "github.com/exoscale/terraform-provider-exoscale/pkg/provider/config"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ResourceACL{}
+var _ resource.ResourceWithImportState = &ResourceACL{}
+
+// aclConfigMutex serializes the updates of the ACL configurations, which the API
+// only allows to replace as a whole.
+var aclConfigMutex sync.Mutex
+
+func NewResourceACL() resource.Resource {
+	return &ResourceACL{}
+}
+
+// ResourceACL defines the DBaaS Service ACL resource implementation.
+type ResourceACL struct {
+	client *exoscale.Client
+	env    string
+}
+
+// ResourceACLModel describes the DBaaS Service ACL resource data model.
+type ResourceACLModel struct {
+	Id       types.String           `tfsdk:"id"`
+	Rules    []ResourceACLRuleModel `tfsdk:"rule"`
+	Service  types.String           `tfsdk:"service"`
+	Username types.String           `tfsdk:"username"`
+	Zone     types.String           `tfsdk:"zone"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+// ResourceACLRuleModel describes a DBaaS Service ACL rule data model.
+type ResourceACLRuleModel struct {
+	Pattern    types.String `tfsdk:"pattern"`
+	Permission types.String `tfsdk:"permission"`
+}
+
+func (r *ResourceACL) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_database_acl"
+}
+
+func (r *ResourceACL) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manage the ACL rules of a user of an Exoscale [OpenSearch Database Service](https://community.exoscale.com/documentation/dbaas/). ACLs are enabled on the service when setting rules.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of this resource (`<service>/<username>`).",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"service": schema.StringAttribute{
+				MarkdownDescription: "❗ The name of the database service.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"username": schema.StringAttribute{
+				MarkdownDescription: "❗ The name of the user the rules apply to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"zone": schema.StringAttribute{
+				MarkdownDescription: "❗ The Exoscale [Zone](https://www.exoscale.com/datacenters/) name.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(config.Zones...),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"rule": schema.ListNestedBlock{
+				MarkdownDescription: "(can be used multiple times) An ACL rule.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"pattern": schema.StringAttribute{
+							MarkdownDescription: "The index pattern the rule applies to (e.g. `logs-*`).",
+							Required:            true,
+						},
+						"permission": schema.StringAttribute{
+							MarkdownDescription: "The permission granted on the matching indexes (`admin`, `read`, `readwrite`, `write` or `deny`).",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(
+									string(oapi.EnumOpensearchRulePermissionAdmin),
+									string(oapi.EnumOpensearchRulePermissionRead),
+									string(oapi.EnumOpensearchRulePermissionReadwrite),
+									string(oapi.EnumOpensearchRulePermissionWrite),
+									string(oapi.EnumOpensearchRulePermissionDeny),
+								),
+							},
+						},
+					},
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+			"timeouts": timeouts.BlockAll(ctx),
+		},
+	}
+}
+
+func (r *ResourceACL) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*providerConfig.ExoscaleProviderConfig).ClientV2
+	r.env = req.ProviderData.(*providerConfig.ExoscaleProviderConfig).Environment
+}
+
+func (r *ResourceACL) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ResourceACLModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set timeout
+	t, diags := data.Timeouts.Create(ctx, config.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, t)
+	defer cancel()
+
+	ctx = exoapi.WithEndpoint(ctx, exoapi.NewReqEndpoint(r.env, data.Zone.ValueString()))
+
+	if err := r.write(ctx, &data, false); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create database ACL, got error: %s", err))
+		return
+	}
+
+	data.Id = types.StringValue(fmt.Sprintf("%s/%s", data.Service.ValueString(), data.Username.ValueString()))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	tflog.Trace(ctx, "resource created", map[string]interface{}{
+		"id": data.Id,
+	})
+}
+
+func (r *ResourceACL) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ResourceACLModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set timeout
+	t, diags := data.Timeouts.Read(ctx, config.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, t)
+	defer cancel()
+
+	ctx = exoapi.WithEndpoint(ctx, exoapi.NewReqEndpoint(r.env, data.Zone.ValueString()))
+
+	found, err := r.read(ctx, &data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read database ACL, got error: %s", err))
+		return
+	}
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	tflog.Trace(ctx, "resource read done", map[string]interface{}{
+		"id": data.Id,
+	})
+}
+
+func (r *ResourceACL) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var stateData, planData ResourceACLModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &planData)...)
+	// Read Terraform state data (for comparison) into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &stateData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set timeout
+	t, diags := stateData.Timeouts.Update(ctx, config.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, t)
+	defer cancel()
+
+	ctx = exoapi.WithEndpoint(ctx, exoapi.NewReqEndpoint(r.env, planData.Zone.ValueString()))
+
+	if err := r.write(ctx, &planData, false); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update database ACL, got error: %s", err))
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &planData)...)
+
+	tflog.Trace(ctx, "resource updated", map[string]interface{}{
+		"id": planData.Id,
+	})
+}
+
+func (r *ResourceACL) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ResourceACLModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set timeout
+	t, diags := data.Timeouts.Delete(ctx, config.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, t)
+	defer cancel()
+
+	ctx = exoapi.WithEndpoint(ctx, exoapi.NewReqEndpoint(r.env, data.Zone.ValueString()))
+
+	if err := r.write(ctx, &data, true); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete database ACL, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "resource deleted", map[string]interface{}{
+		"id": data.Id,
+	})
+}
+
+func (r *ResourceACL) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	service, username, zone, err := parseServiceObjectImportID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: service/username@zone. Got: %q", req.ID),
+		)
+		return
+	}
+
+	var data ResourceACLModel
+
+	// Set timeouts (quirk https://github.com/hashicorp/terraform-plugin-framework-timeouts/issues/46)
+	var timeouts timeouts.Value
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("timeouts"), &timeouts)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Timeouts = timeouts
+
+	data.Id = types.StringValue(fmt.Sprintf("%s/%s", service, username))
+	data.Service = types.StringValue(service)
+	data.Username = types.StringValue(username)
+	data.Zone = types.StringValue(zone)
+
+	ctx = exoapi.WithEndpoint(ctx, exoapi.NewReqEndpoint(r.env, zone))
+
+	found, err := r.read(ctx, &data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read database ACL, got error: %s", err))
+		return
+	}
+	if !found {
+		resp.Diagnostics.AddError("Not Found", fmt.Sprintf("Database ACL %q not found", req.ID))
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	tflog.Trace(ctx, "resource imported", map[string]interface{}{
+		"id": data.Id,
+	})
+}
+
+// getConfig retrieves the ACL configuration of the database service.
+func (r *ResourceACL) getConfig(ctx context.Context, service string) (*oapi.DbaasOpensearchAclConfig, error) {
+	res, err := r.client.GetDbaasOpensearchAclConfigWithResponse(ctx, oapi.DbaasServiceName(service))
+	if err != nil {
+		return nil, err
+	}
+	if res.StatusCode() != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status: %s", res.Status())
+	}
+
+	return res.JSON200, nil
+}
+
+// read fetches the user ACL rules from the database service and updates the model accordingly.
+// It reports whether ACL rules were found for the user.
+func (r *ResourceACL) read(ctx context.Context, data *ResourceACLModel) (bool, error) {
+	aclConfig, err := r.getConfig(ctx, data.Service.ValueString())
+	if err != nil {
+		return false, err
+	}
+
+	if aclConfig.Acls == nil {
+		return false, nil
+	}
+
+	for _, acl := range *aclConfig.Acls {
+		if acl.Username == nil || string(*acl.Username) != data.Username.ValueString() {
+			continue
+		}
+
+		rules := make([]ResourceACLRuleModel, 0)
+		if acl.Rules != nil {
+			for _, rule := range *acl.Rules {
+				m := ResourceACLRuleModel{
+					Pattern:    types.StringPointerValue(rule.Index),
+					Permission: types.StringNull(),
+				}
+				if rule.Permission != nil {
+					m.Permission = types.StringValue(string(*rule.Permission))
+				}
+				rules = append(rules, m)
+			}
+		}
+		data.Rules = rules
+
+		return true, nil
+	}
+
+	return false, nil
+}
+
+// write replaces (or removes) the user ACL rules in the ACL configuration of the database service,
+// preserving the rules of the other users.
+func (r *ResourceACL) write(ctx context.Context, data *ResourceACLModel, remove bool) error {
+	aclConfigMutex.Lock()
+	defer aclConfigMutex.Unlock()
+
+	aclConfig, err := r.getConfig(ctx, data.Service.ValueString())
+	if err != nil {
+		return err
+	}
+
+	acls := make(oapi.DbaasOpensearchAcls, 0)
+	if aclConfig.Acls != nil {
+		for _, acl := range *aclConfig.Acls {
+			if acl.Username != nil && string(*acl.Username) == data.Username.ValueString() {
+				continue
+			}
+			acls = append(acls, acl)
+		}
+	}
+
+	if !remove {
+		rules := make([]oapi.DbaasOpensearchRule, len(data.Rules))
+		for i, rule := range data.Rules {
+			permission := oapi.EnumOpensearchRulePermission(rule.Permission.ValueString())
+			rules[i] = oapi.DbaasOpensearchRule{
+				Index:      rule.Pattern.ValueStringPointer(),
+				Permission: &permission,
+			}
+		}
+
+		username := oapi.DbaasUserUsername(data.Username.ValueString())
+		acls = append(acls, oapi.DbaasOpensearchAcl{
+			Rules:    &rules,
+			Username: &username,
+		})
+
+		enabled := true
+		aclConfig.AclEnabled = &enabled
+	}
+	aclConfig.Acls = &acls
+
+	res, err := r.client.UpdateDbaasOpensearchAclConfigWithResponse(
+		ctx,
+		oapi.DbaasServiceName(data.Service.ValueString()),
+		oapi.UpdateDbaasOpensearchAclConfigJSONRequestBody(*aclConfig),
+	)
+	if err != nil {
+		return err
+	}
+	if res.StatusCode() != http.StatusOK {
+		return fmt.Errorf("unexpected status: %s", res.Status())
+	}
+
+	return nil
+}
@@ -0,0 +1,159 @@
+package database_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"text/template"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	exoapi "github.com/exoscale/egoscale/v2/api"
+	"github.com/exoscale/egoscale/v2/oapi"
+
+	"github.com/exoscale/terraform-provider-exoscale/pkg/testutils"
+)
+
+type TemplateModelACLRule struct {
+	Pattern    string
+	Permission string
+}
+
+type TemplateModelACL struct {
+	ResourceName string
+
+	Service string
+	Plan    string
+	Zone    string
+
+	Username string
+	Rules    []TemplateModelACLRule
+}
+
+func testResourceACL(t *testing.T) {
+	tpl, err := template.ParseFiles("testdata/resource_acl.tmpl")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fullResourceName := "exoscale_database_acl.test"
+	dataBase := TemplateModelACL{
+		ResourceName: "test",
+		Service:      acctest.RandomWithPrefix(testutils.Prefix),
+		Plan:         "hobbyist-2",
+		Zone:         testutils.TestZoneName,
+		Username:     "avnadmin",
+	}
+
+	dataCreate := dataBase
+	dataCreate.Rules = []TemplateModelACLRule{
+		{Pattern: "logs-*", Permission: "read"},
+	}
+	buf := &bytes.Buffer{}
+	err = tpl.Execute(buf, &dataCreate)
+	if err != nil {
+		t.Fatal(err)
+	}
+	configCreate := buf.String()
+
+	dataUpdate := dataBase
+	dataUpdate.Rules = []TemplateModelACLRule{
+		{Pattern: "logs-*", Permission: "readwrite"},
+		{Pattern: "metrics-*", Permission: "deny"},
+	}
+	buf = &bytes.Buffer{}
+	err = tpl.Execute(buf, &dataUpdate)
+	if err != nil {
+		t.Fatal(err)
+	}
+	configUpdate := buf.String()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutils.AccPreCheck(t) },
+		CheckDestroy:             CheckDestroy("opensearch", dataBase.Service),
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// Create
+				Config: configCreate,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(fullResourceName, "rule.#", "1"),
+					func(s *terraform.State) error {
+						return CheckExistsACL(dataBase.Service, &dataCreate)
+					},
+				),
+			},
+			{
+				// Update
+				Config: configUpdate,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(fullResourceName, "rule.#", "2"),
+					func(s *terraform.State) error {
+						return CheckExistsACL(dataBase.Service, &dataUpdate)
+					},
+				),
+			},
+			{
+				// Import
+				ResourceName: fullResourceName,
+				ImportStateIdFunc: func() resource.ImportStateIdFunc {
+					return func(*terraform.State) (string, error) {
+						return fmt.Sprintf("%s/%s@%s", dataBase.Service, dataBase.Username, dataBase.Zone), nil
+					}
+				}(),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func CheckExistsACL(service string, data *TemplateModelACL) error {
+	client, err := testutils.APIClient()
+	if err != nil {
+		return err
+	}
+
+	ctx := exoapi.WithEndpoint(context.Background(), exoapi.NewReqEndpoint(testutils.TestEnvironment(), testutils.TestZoneName))
+
+	res, err := client.GetDbaasOpensearchAclConfigWithResponse(ctx, oapi.DbaasServiceName(service))
+	if err != nil {
+		return err
+	}
+	if res.StatusCode() != http.StatusOK {
+		return fmt.Errorf("API request error: unexpected status %s", res.Status())
+	}
+
+	if res.JSON200.AclEnabled == nil || !*res.JSON200.AclEnabled {
+		return fmt.Errorf("ACLs not enabled on service %q", service)
+	}
+
+	if res.JSON200.Acls != nil {
+		for _, acl := range *res.JSON200.Acls {
+			if acl.Username == nil || string(*acl.Username) != data.Username {
+				continue
+			}
+
+			if acl.Rules == nil || len(*acl.Rules) != len(data.Rules) {
+				return fmt.Errorf("rules: expected %d rules, got %v", len(data.Rules), acl.Rules)
+			}
+
+			for i, rule := range *acl.Rules {
+				if v := *rule.Index; v != data.Rules[i].Pattern {
+					return fmt.Errorf("rule #%d pattern: expected %q, got %q", i, data.Rules[i].Pattern, v)
+				}
+				if v := string(*rule.Permission); v != data.Rules[i].Permission {
+					return fmt.Errorf("rule #%d permission: expected %q, got %q", i, data.Rules[i].Permission, v)
+				}
+			}
+
+			return nil
+		}
+	}
+
+	return fmt.Errorf("ACL of user %q not found", data.Username)
+}
@@ -0,0 +1,445 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	exoscale "github.com/exoscale/egoscale/v2"
+	exoapi "github.com/exoscale/egoscale/v2/api"
+	"github.com/exoscale/egoscale/v2/oapi"
+
+	"github.com/exoscale/terraform-provider-exoscale/pkg/config"
+	providerConfig "github.com/exoscale/terraform-provider-exoscale/pkg/provider/config"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ResourceConnectionPool{}
+var _ resource.ResourceWithImportState = &ResourceConnectionPool{}
+
+func NewResourceConnectionPool() resource.Resource {
+	return &ResourceConnectionPool{}
+}
+
+// ResourceConnectionPool defines the DBaaS Service connection pool resource implementation.
+type ResourceConnectionPool struct {
+	client *exoscale.Client
+	env    string
+}
+
+// ResourceConnectionPoolModel describes the DBaaS Service connection pool resource data model.
+type ResourceConnectionPoolModel struct {
+	Id            types.String `tfsdk:"id"`
+	ConnectionURI types.String `tfsdk:"connection_uri"`
+	Database      types.String `tfsdk:"database"`
+	Mode          types.String `tfsdk:"mode"`
+	Name          types.String `tfsdk:"name"`
+	Service       types.String `tfsdk:"service"`
+	Size          types.Int64  `tfsdk:"size"`
+	Username      types.String `tfsdk:"username"`
+	Zone          types.String `tfsdk:"zone"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *ResourceConnectionPool) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_database_connection_pool"
+}
+
+func (r *ResourceConnectionPool) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manage connection pools of an Exoscale [PostgreSQL Database Service](https://community.exoscale.com/documentation/dbaas/).",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of this resource (`<service>/<name>`).",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"connection_uri": schema.StringAttribute{
+				MarkdownDescription: "The connection URI of the pool.",
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"database": schema.StringAttribute{
+				MarkdownDescription: "The name of the database the pool connects to.",
+				Required:            true,
+			},
+			"mode": schema.StringAttribute{
+				MarkdownDescription: "The pooling mode (`session`, `transaction`, `statement`).",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(oapi.EnumPgPoolModeSession),
+						string(oapi.EnumPgPoolModeTransaction),
+						string(oapi.EnumPgPoolModeStatement),
+					),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "❗ The name of the connection pool.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"service": schema.StringAttribute{
+				MarkdownDescription: "❗ The name of the database service.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"size": schema.Int64Attribute{
+				MarkdownDescription: "The number of connections of the pool.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"username": schema.StringAttribute{
+				MarkdownDescription: "The name of the user used by the pool to connect to the database (defaults to the connecting client user).",
+				Optional:            true,
+				Computed:            true,
+			},
+			"zone": schema.StringAttribute{
+				MarkdownDescription: "❗ The Exoscale [Zone](https://www.exoscale.com/datacenters/) name.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(config.Zones...),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
+	}
+}
+
+func (r *ResourceConnectionPool) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*providerConfig.ExoscaleProviderConfig).ClientV2
+	r.env = req.ProviderData.(*providerConfig.ExoscaleProviderConfig).Environment
+}
+
+func (r *ResourceConnectionPool) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ResourceConnectionPoolModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set timeout
+	t, diags := data.Timeouts.Create(ctx, config.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, t)
+	defer cancel()
+
+	ctx = exoapi.WithEndpoint(ctx, exoapi.NewReqEndpoint(r.env, data.Zone.ValueString()))
+
+	body := oapi.CreateDbaasPgConnectionPoolJSONRequestBody{
+		DatabaseName: oapi.DbaasPgDatabaseName(data.Database.ValueString()),
+		Name:         oapi.DbaasPgPoolName(data.Name.ValueString()),
+	}
+	if !data.Mode.IsUnknown() {
+		mode := oapi.EnumPgPoolMode(data.Mode.ValueString())
+		body.Mode = &mode
+	}
+	if !data.Size.IsUnknown() {
+		size := oapi.DbaasPgPoolSize(data.Size.ValueInt64())
+		body.Size = &size
+	}
+	if !data.Username.IsUnknown() {
+		username := oapi.DbaasPgPoolUsername(data.Username.ValueString())
+		body.Username = &username
+	}
+
+	res, err := r.client.CreateDbaasPgConnectionPoolWithResponse(ctx, oapi.DbaasServiceName(data.Service.ValueString()), body)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create database connection pool, got error: %s", err))
+		return
+	}
+	if res.StatusCode() != http.StatusOK {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create database connection pool, unexpected status: %s", res.Status()))
+		return
+	}
+
+	data.Id = types.StringValue(fmt.Sprintf("%s/%s", data.Service.ValueString(), data.Name.ValueString()))
+
+	// Connection pool creation is asynchronous: wait for the pool to be listed by the service.
+	_, err = oapi.NewPoller().
+		Poll(ctx, func(ctx context.Context) (bool, interface{}, error) {
+			found, err := r.read(ctx, &data)
+			return found, nil, err
+		})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read database connection pool, got error: %s", err))
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	tflog.Trace(ctx, "resource created", map[string]interface{}{
+		"id": data.Id,
+	})
+}
+
+func (r *ResourceConnectionPool) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ResourceConnectionPoolModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set timeout
+	t, diags := data.Timeouts.Read(ctx, config.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, t)
+	defer cancel()
+
+	ctx = exoapi.WithEndpoint(ctx, exoapi.NewReqEndpoint(r.env, data.Zone.ValueString()))
+
+	found, err := r.read(ctx, &data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read database connection pool, got error: %s", err))
+		return
+	}
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	tflog.Trace(ctx, "resource read done", map[string]interface{}{
+		"id": data.Id,
+	})
+}
+
+func (r *ResourceConnectionPool) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var stateData, planData ResourceConnectionPoolModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &planData)...)
+	// Read Terraform state data (for comparison) into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &stateData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set timeout
+	t, diags := stateData.Timeouts.Update(ctx, config.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, t)
+	defer cancel()
+
+	ctx = exoapi.WithEndpoint(ctx, exoapi.NewReqEndpoint(r.env, planData.Zone.ValueString()))
+
+	var updated bool
+	body := oapi.UpdateDbaasPgConnectionPoolJSONRequestBody{}
+
+	if !planData.Database.Equal(stateData.Database) {
+		database := oapi.DbaasPgDatabaseName(planData.Database.ValueString())
+		body.DatabaseName = &database
+		updated = true
+	}
+	if !planData.Mode.IsUnknown() && !planData.Mode.Equal(stateData.Mode) {
+		mode := oapi.EnumPgPoolMode(planData.Mode.ValueString())
+		body.Mode = &mode
+		updated = true
+	}
+	if !planData.Size.IsUnknown() && !planData.Size.Equal(stateData.Size) {
+		size := oapi.DbaasPgPoolSize(planData.Size.ValueInt64())
+		body.Size = &size
+		updated = true
+	}
+	if !planData.Username.IsUnknown() && !planData.Username.Equal(stateData.Username) {
+		username := oapi.DbaasPgPoolUsername(planData.Username.ValueString())
+		body.Username = &username
+		updated = true
+	}
+
+	if updated {
+		res, err := r.client.UpdateDbaasPgConnectionPoolWithResponse(
+			ctx,
+			oapi.DbaasServiceName(planData.Service.ValueString()),
+			oapi.DbaasPgPoolName(planData.Name.ValueString()),
+			body,
+		)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update database connection pool, got error: %s", err))
+			return
+		}
+		if res.StatusCode() != http.StatusOK {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update database connection pool, unexpected status: %s", res.Status()))
+			return
+		}
+	}
+
+	if _, err := r.read(ctx, &planData); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read database connection pool, got error: %s", err))
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &planData)...)
+
+	tflog.Trace(ctx, "resource updated", map[string]interface{}{
+		"id": planData.Id,
+	})
+}
+
+func (r *ResourceConnectionPool) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ResourceConnectionPoolModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set timeout
+	t, diags := data.Timeouts.Delete(ctx, config.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, t)
+	defer cancel()
+
+	ctx = exoapi.WithEndpoint(ctx, exoapi.NewReqEndpoint(r.env, data.Zone.ValueString()))
+
+	res, err := r.client.DeleteDbaasPgConnectionPoolWithResponse(
+		ctx,
+		oapi.DbaasServiceName(data.Service.ValueString()),
+		oapi.DbaasPgPoolName(data.Name.ValueString()),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete database connection pool, got error: %s", err))
+		return
+	}
+	if res.StatusCode() != http.StatusOK {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete database connection pool, unexpected status: %s", res.Status()))
+		return
+	}
+
+	tflog.Trace(ctx, "resource deleted", map[string]interface{}{
+		"id": data.Id,
+	})
+}
+
+func (r *ResourceConnectionPool) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	service, name, zone, err := parseServiceObjectImportID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: service/name@zone. Got: %q", req.ID),
+		)
+		return
+	}
+
+	var data ResourceConnectionPoolModel
+
+	// Set timeouts (quirk https://github.com/hashicorp/terraform-plugin-framework-timeouts/issues/46)
+	var timeouts timeouts.Value
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("timeouts"), &timeouts)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Timeouts = timeouts
+
+	data.Id = types.StringValue(fmt.Sprintf("%s/%s", service, name))
+	data.Service = types.StringValue(service)
+	data.Name = types.StringValue(name)
+	data.Zone = types.StringValue(zone)
+
+	ctx = exoapi.WithEndpoint(ctx, exoapi.NewReqEndpoint(r.env, zone))
+
+	found, err := r.read(ctx, &data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read database connection pool, got error: %s", err))
+		return
+	}
+	if !found {
+		resp.Diagnostics.AddError("Not Found", fmt.Sprintf("Database connection pool %q not found", req.ID))
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	tflog.Trace(ctx, "resource imported", map[string]interface{}{
+		"id": data.Id,
+	})
+}
+
+// read fetches the connection pool from the database service and updates the model accordingly.
+// It reports whether the connection pool was found.
+func (r *ResourceConnectionPool) read(ctx context.Context, data *ResourceConnectionPoolModel) (bool, error) {
+	res, err := r.client.GetDbaasServicePgWithResponse(ctx, oapi.DbaasServiceName(data.Service.ValueString()))
+	if err != nil {
+		return false, err
+	}
+	if res.StatusCode() != http.StatusOK {
+		return false, fmt.Errorf("unexpected status: %s", res.Status())
+	}
+
+	if res.JSON200.ConnectionPools == nil {
+		return false, nil
+	}
+
+	for _, pool := range *res.JSON200.ConnectionPools {
+		if string(pool.Name) != data.Name.ValueString() {
+			continue
+		}
+
+		data.ConnectionURI = types.StringValue(pool.ConnectionUri)
+		data.Database = types.StringValue(string(pool.Database))
+		data.Mode = types.StringValue(string(pool.Mode))
+		data.Size = types.Int64Value(int64(pool.Size))
+		data.Username = types.StringValue(string(pool.Username))
+
+		return true, nil
+	}
+
+	return false, nil
+}
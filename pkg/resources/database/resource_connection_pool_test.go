@@ -0,0 +1,148 @@
+package database_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"text/template"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	exoapi "github.com/exoscale/egoscale/v2/api"
+	"github.com/exoscale/egoscale/v2/oapi"
+
+	"github.com/exoscale/terraform-provider-exoscale/pkg/testutils"
+)
+
+type TemplateModelConnectionPool struct {
+	ResourceName string
+
+	Service string
+	Plan    string
+	Zone    string
+
+	Name     string
+	Database string
+	Mode     string
+	Size     int64
+}
+
+func testResourceConnectionPool(t *testing.T) {
+	tpl, err := template.ParseFiles("testdata/resource_connection_pool.tmpl")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fullResourceName := "exoscale_database_connection_pool.test"
+	dataBase := TemplateModelConnectionPool{
+		ResourceName: "test",
+		Service:      acctest.RandomWithPrefix(testutils.Prefix),
+		Plan:         "hobbyist-2",
+		Zone:         testutils.TestZoneName,
+		Name:         "app-pool",
+		Database:     "defaultdb",
+	}
+
+	dataCreate := dataBase
+	dataCreate.Mode = "transaction"
+	dataCreate.Size = 10
+	buf := &bytes.Buffer{}
+	err = tpl.Execute(buf, &dataCreate)
+	if err != nil {
+		t.Fatal(err)
+	}
+	configCreate := buf.String()
+
+	dataUpdate := dataBase
+	dataUpdate.Mode = "session"
+	dataUpdate.Size = 5
+	buf = &bytes.Buffer{}
+	err = tpl.Execute(buf, &dataUpdate)
+	if err != nil {
+		t.Fatal(err)
+	}
+	configUpdate := buf.String()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutils.AccPreCheck(t) },
+		CheckDestroy:             CheckDestroy("pg", dataBase.Service),
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// Create
+				Config: configCreate,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(fullResourceName, "connection_uri"),
+					func(s *terraform.State) error {
+						return CheckExistsConnectionPool(dataBase.Service, &dataCreate)
+					},
+				),
+			},
+			{
+				// Update
+				Config: configUpdate,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					func(s *terraform.State) error {
+						return CheckExistsConnectionPool(dataBase.Service, &dataUpdate)
+					},
+				),
+			},
+			{
+				// Import
+				ResourceName: fullResourceName,
+				ImportStateIdFunc: func() resource.ImportStateIdFunc {
+					return func(*terraform.State) (string, error) {
+						return fmt.Sprintf("%s/%s@%s", dataBase.Service, dataBase.Name, dataBase.Zone), nil
+					}
+				}(),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func CheckExistsConnectionPool(service string, data *TemplateModelConnectionPool) error {
+	client, err := testutils.APIClient()
+	if err != nil {
+		return err
+	}
+
+	ctx := exoapi.WithEndpoint(context.Background(), exoapi.NewReqEndpoint(testutils.TestEnvironment(), testutils.TestZoneName))
+
+	res, err := client.GetDbaasServicePgWithResponse(ctx, oapi.DbaasServiceName(service))
+	if err != nil {
+		return err
+	}
+	if res.StatusCode() != http.StatusOK {
+		return fmt.Errorf("API request error: unexpected status %s", res.Status())
+	}
+
+	if res.JSON200.ConnectionPools != nil {
+		for _, pool := range *res.JSON200.ConnectionPools {
+			if string(pool.Name) != data.Name {
+				continue
+			}
+
+			if v := string(pool.Database); v != data.Database {
+				return fmt.Errorf("database: expected %q, got %q", data.Database, v)
+			}
+
+			if v := string(pool.Mode); v != data.Mode {
+				return fmt.Errorf("mode: expected %q, got %q", data.Mode, v)
+			}
+
+			if v := int64(pool.Size); v != data.Size {
+				return fmt.Errorf("size: expected %d, got %d", data.Size, v)
+			}
+
+			return nil
+		}
+	}
+
+	return fmt.Errorf("connection pool %q not found", data.Name)
+}
@@ -0,0 +1,368 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	exoscale "github.com/exoscale/egoscale/v2"
+	exoapi "github.com/exoscale/egoscale/v2/api"
+	"github.com/exoscale/egoscale/v2/oapi"
+
+	"github.com/exoscale/terraform-provider-exoscale/pkg/config"
+	providerConfig "github.com/exoscale/terraform-provider-exoscale/pkg/provider/config"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ResourceIntegration{}
+var _ resource.ResourceWithImportState = &ResourceIntegration{}
+
+func NewResourceIntegration() resource.Resource {
+	return &ResourceIntegration{}
+}
+
+// ResourceIntegration defines the DBaaS Service integration resource implementation.
+type ResourceIntegration struct {
+	client *exoscale.Client
+	env    string
+}
+
+// ResourceIntegrationModel describes the DBaaS Service integration resource data model.
+type ResourceIntegrationModel struct {
+	Id            types.String `tfsdk:"id"`
+	DestService   types.String `tfsdk:"dest_service"`
+	IsActive      types.Bool   `tfsdk:"is_active"`
+	SourceService types.String `tfsdk:"source_service"`
+	Status        types.String `tfsdk:"status"`
+	Type          types.String `tfsdk:"type"`
+	Zone          types.String `tfsdk:"zone"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *ResourceIntegration) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_database_integration"
+}
+
+func (r *ResourceIntegration) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Manage integrations between Exoscale [Database Services](https://community.exoscale.com/documentation/dbaas/).
+
+Integrations allow e.g. forwarding the metrics of a database service to another one (` + "`metrics`" + `), or exposing a database service as a Grafana datasource (` + "`datasource`" + `).`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the integration.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"dest_service": schema.StringAttribute{
+				MarkdownDescription: "❗ The name of the destination database service.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"is_active": schema.BoolAttribute{
+				MarkdownDescription: "Whether the integration is active.",
+				Computed:            true,
+			},
+			"source_service": schema.StringAttribute{
+				MarkdownDescription: "❗ The name of the source database service.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "The integration status.",
+				Computed:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "❗ The integration type (`datasource`, `metrics`, `read_replica`).",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(oapi.EnumIntegrationTypesDatasource),
+						string(oapi.EnumIntegrationTypesMetrics),
+						string(oapi.EnumIntegrationTypesReadReplica),
+					),
+				},
+			},
+			"zone": schema.StringAttribute{
+				MarkdownDescription: "❗ The Exoscale [Zone](https://www.exoscale.com/datacenters/) name.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(config.Zones...),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
+	}
+}
+
+func (r *ResourceIntegration) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*providerConfig.ExoscaleProviderConfig).ClientV2
+	r.env = req.ProviderData.(*providerConfig.ExoscaleProviderConfig).Environment
+}
+
+func (r *ResourceIntegration) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ResourceIntegrationModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set timeout
+	t, diags := data.Timeouts.Create(ctx, config.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, t)
+	defer cancel()
+
+	zone := data.Zone.ValueString()
+	ctx = exoapi.WithEndpoint(ctx, exoapi.NewReqEndpoint(r.env, zone))
+
+	res, err := r.client.CreateDbaasIntegrationWithResponse(ctx, oapi.CreateDbaasIntegrationJSONRequestBody{
+		DestService:     oapi.DbaasServiceName(data.DestService.ValueString()),
+		IntegrationType: data.Type.ValueString(),
+		SourceService:   oapi.DbaasServiceName(data.SourceService.ValueString()),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create database integration, got error: %s", err))
+		return
+	}
+	if res.StatusCode() != http.StatusOK {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create database integration, unexpected status: %s", res.Status()))
+		return
+	}
+
+	op, err := oapi.NewPoller().Poll(ctx, oapi.OperationPoller(r.client, zone, *res.JSON200.Id))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create database integration, got error: %s", err))
+		return
+	}
+
+	data.Id = types.StringValue(*op.(*struct {
+		Command *string `json:"command,omitempty"`
+		Id      *string `json:"id,omitempty"` // revive:disable-line
+		Link    *string `json:"link,omitempty"`
+	}).Id)
+
+	if _, err := r.read(ctx, &data); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read database integration, got error: %s", err))
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	tflog.Trace(ctx, "resource created", map[string]interface{}{
+		"id": data.Id,
+	})
+}
+
+func (r *ResourceIntegration) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ResourceIntegrationModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set timeout
+	t, diags := data.Timeouts.Read(ctx, config.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, t)
+	defer cancel()
+
+	ctx = exoapi.WithEndpoint(ctx, exoapi.NewReqEndpoint(r.env, data.Zone.ValueString()))
+
+	found, err := r.read(ctx, &data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read database integration, got error: %s", err))
+		return
+	}
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	tflog.Trace(ctx, "resource read done", map[string]interface{}{
+		"id": data.Id,
+	})
+}
+
+// Update only refreshes the state: every configurable attribute requires a replacement.
+func (r *ResourceIntegration) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ResourceIntegrationModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = exoapi.WithEndpoint(ctx, exoapi.NewReqEndpoint(r.env, data.Zone.ValueString()))
+
+	if _, err := r.read(ctx, &data); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read database integration, got error: %s", err))
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ResourceIntegration) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ResourceIntegrationModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set timeout
+	t, diags := data.Timeouts.Delete(ctx, config.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, t)
+	defer cancel()
+
+	zone := data.Zone.ValueString()
+	ctx = exoapi.WithEndpoint(ctx, exoapi.NewReqEndpoint(r.env, zone))
+
+	res, err := r.client.DeleteDbaasIntegrationWithResponse(ctx, data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete database integration, got error: %s", err))
+		return
+	}
+	if res.StatusCode() != http.StatusOK {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete database integration, unexpected status: %s", res.Status()))
+		return
+	}
+
+	if _, err := oapi.NewPoller().Poll(ctx, oapi.OperationPoller(r.client, zone, *res.JSON200.Id)); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete database integration, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "resource deleted", map[string]interface{}{
+		"id": data.Id,
+	})
+}
+
+func (r *ResourceIntegration) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, "@")
+
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: id@zone. Got: %q", req.ID),
+		)
+		return
+	}
+
+	var data ResourceIntegrationModel
+
+	// Set timeouts (quirk https://github.com/hashicorp/terraform-plugin-framework-timeouts/issues/46)
+	var timeouts timeouts.Value
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("timeouts"), &timeouts)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Timeouts = timeouts
+
+	data.Id = types.StringValue(idParts[0])
+	data.Zone = types.StringValue(idParts[1])
+
+	ctx = exoapi.WithEndpoint(ctx, exoapi.NewReqEndpoint(r.env, idParts[1]))
+
+	found, err := r.read(ctx, &data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read database integration, got error: %s", err))
+		return
+	}
+	if !found {
+		resp.Diagnostics.AddError("Not Found", fmt.Sprintf("Database integration %q not found", req.ID))
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	tflog.Trace(ctx, "resource imported", map[string]interface{}{
+		"id": data.Id,
+	})
+}
+
+// read fetches the integration and updates the model accordingly.
+// It reports whether the integration was found.
+func (r *ResourceIntegration) read(ctx context.Context, data *ResourceIntegrationModel) (bool, error) {
+	res, err := r.client.GetDbaasIntegrationWithResponse(ctx, data.Id.ValueString())
+	if err != nil {
+		return false, err
+	}
+	if res.StatusCode() == http.StatusNotFound {
+		return false, nil
+	}
+	if res.StatusCode() != http.StatusOK {
+		return false, fmt.Errorf("unexpected status: %s", res.Status())
+	}
+
+	integration := res.JSON200
+
+	if integration.Dest != nil {
+		data.DestService = types.StringValue(*integration.Dest)
+	}
+	if integration.Source != nil {
+		data.SourceService = types.StringValue(*integration.Source)
+	}
+	if integration.Type != nil {
+		data.Type = types.StringValue(*integration.Type)
+	}
+
+	data.IsActive = types.BoolValue(integration.IsActive != nil && *integration.IsActive)
+	data.Status = types.StringPointerValue(integration.Status)
+
+	return true, nil
+}
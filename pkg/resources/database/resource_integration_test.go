@@ -0,0 +1,122 @@
+package database_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"text/template"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	exoapi "github.com/exoscale/egoscale/v2/api"
+
+	"github.com/exoscale/terraform-provider-exoscale/pkg/testutils"
+)
+
+type TemplateModelIntegration struct {
+	ResourceName string
+
+	SourceService string
+	SourcePlan    string
+	DestService   string
+	DestPlan      string
+	Zone          string
+
+	Type string
+}
+
+func testResourceIntegration(t *testing.T) {
+	tpl, err := template.ParseFiles("testdata/resource_integration.tmpl")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fullResourceName := "exoscale_database_integration.test"
+	data := TemplateModelIntegration{
+		ResourceName:  "test",
+		SourceService: acctest.RandomWithPrefix(testutils.Prefix),
+		SourcePlan:    "hobbyist-2",
+		DestService:   acctest.RandomWithPrefix(testutils.Prefix),
+		DestPlan:      "hobbyist-2",
+		Zone:          testutils.TestZoneName,
+		Type:          "datasource",
+	}
+
+	buf := &bytes.Buffer{}
+	err = tpl.Execute(buf, &data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	config := buf.String()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { testutils.AccPreCheck(t) },
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			CheckDestroy("pg", data.SourceService),
+			CheckDestroy("grafana", data.DestService),
+		),
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// Create
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(fullResourceName, "id"),
+					resource.TestCheckResourceAttr(fullResourceName, "type", data.Type),
+					resource.TestCheckResourceAttr(fullResourceName, "source_service", data.SourceService),
+					resource.TestCheckResourceAttr(fullResourceName, "dest_service", data.DestService),
+					func(s *terraform.State) error {
+						return CheckExistsIntegration(s.RootModule().Resources[fullResourceName].Primary.ID, &data)
+					},
+				),
+			},
+			{
+				// Import
+				ResourceName: fullResourceName,
+				ImportStateIdFunc: func() resource.ImportStateIdFunc {
+					return func(s *terraform.State) (string, error) {
+						return fmt.Sprintf("%s@%s", s.RootModule().Resources[fullResourceName].Primary.ID, data.Zone), nil
+					}
+				}(),
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"is_active", "status"},
+			},
+		},
+	})
+}
+
+func CheckExistsIntegration(id string, data *TemplateModelIntegration) error {
+	client, err := testutils.APIClient()
+	if err != nil {
+		return err
+	}
+
+	ctx := exoapi.WithEndpoint(context.Background(), exoapi.NewReqEndpoint(testutils.TestEnvironment(), testutils.TestZoneName))
+
+	res, err := client.GetDbaasIntegrationWithResponse(ctx, id)
+	if err != nil {
+		return err
+	}
+	if res.StatusCode() != http.StatusOK {
+		return fmt.Errorf("API request error: unexpected status %s", res.Status())
+	}
+
+	if v := res.JSON200.Source; v == nil || *v != data.SourceService {
+		return fmt.Errorf("source_service: expected %q, got %v", data.SourceService, v)
+	}
+
+	if v := res.JSON200.Dest; v == nil || *v != data.DestService {
+		return fmt.Errorf("dest_service: expected %q, got %v", data.DestService, v)
+	}
+
+	if v := res.JSON200.Type; v == nil || *v != data.Type {
+		return fmt.Errorf("type: expected %q, got %v", data.Type, v)
+	}
+
+	return nil
+}
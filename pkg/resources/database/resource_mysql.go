@@ -8,8 +8,12 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -20,12 +24,14 @@ import (
 )
 
 type ResourceMysqlModel struct {
-	AdminPassword  types.String `tfsdk:"admin_password"`
-	AdminUsername  types.String `tfsdk:"admin_username"`
-	BackupSchedule types.String `tfsdk:"backup_schedule"`
-	IpFilter       types.Set    `tfsdk:"ip_filter"`
-	Settings       types.String `tfsdk:"mysql_settings"`
-	Version        types.String `tfsdk:"version"`
+	AdminPassword      types.String `tfsdk:"admin_password"`
+	AdminUsername      types.String `tfsdk:"admin_username"`
+	BackupSchedule     types.String `tfsdk:"backup_schedule"`
+	ForkFromService    types.String `tfsdk:"fork_from_service"`
+	IpFilter           types.Set    `tfsdk:"ip_filter"`
+	RecoveryBackupTime types.String `tfsdk:"recovery_backup_time"`
+	Settings           types.String `tfsdk:"mysql_settings"`
+	Version            types.String `tfsdk:"version"`
 }
 
 var ResourceMysqlSchema = schema.SingleNestedBlock{
@@ -45,6 +51,13 @@ var ResourceMysqlSchema = schema.SingleNestedBlock{
 			Optional:            true,
 			Computed:            true,
 		},
+		"fork_from_service": schema.StringAttribute{
+			MarkdownDescription: "❗ The name of the service to create this service as a fork of (e.g. to restore its backups into a new service).",
+			Optional:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
 		"ip_filter": schema.SetAttribute{
 			ElementType:         types.StringType,
 			MarkdownDescription: "A list of CIDR blocks to allow incoming connections from.",
@@ -54,6 +67,16 @@ var ResourceMysqlSchema = schema.SingleNestedBlock{
 				setvalidator.ValueStringsAre(validators.IsCIDRNetworkValidator{Min: 0, Max: 128}),
 			},
 		},
+		"recovery_backup_time": schema.StringAttribute{
+			MarkdownDescription: "❗ The point in time (ISO 8601, e.g. `2024-01-31T12:00:00Z`) of the `fork_from_service` backups to restore (default: latest backup).",
+			Optional:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+			Validators: []validator.String{
+				stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("fork_from_service")),
+			},
+		},
 		"mysql_settings": schema.StringAttribute{
 			MarkdownDescription: "MySQL configuration settings in JSON format (`exo dbaas type show mysql --settings=mysql` for reference).",
 			Optional:            true,
@@ -91,6 +114,14 @@ func (r *Resource) createMysql(ctx context.Context, data *ResourceModel, diagnos
 		service.AdminUsername = mysqlData.AdminUsername.ValueStringPointer()
 	}
 
+	if !mysqlData.ForkFromService.IsNull() {
+		service.ForkFromService = (*oapi.DbaasServiceName)(mysqlData.ForkFromService.ValueStringPointer())
+	}
+
+	if !mysqlData.RecoveryBackupTime.IsNull() {
+		service.RecoveryBackupTime = mysqlData.RecoveryBackupTime.ValueStringPointer()
+	}
+
 	if !mysqlData.IpFilter.IsUnknown() {
 		obj := []string{}
 		if len(mysqlData.IpFilter.Elements()) > 0 {
@@ -8,8 +8,12 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -20,14 +24,16 @@ import (
 )
 
 type ResourcePgModel struct {
-	AdminPassword     types.String `tfsdk:"admin_password"`
-	AdminUsername     types.String `tfsdk:"admin_username"`
-	BackupSchedule    types.String `tfsdk:"backup_schedule"`
-	IpFilter          types.Set    `tfsdk:"ip_filter"`
-	Settings          types.String `tfsdk:"pg_settings"`
-	Version           types.String `tfsdk:"version"`
-	PgbouncerSettings types.String `tfsdk:"pgbouncer_settings"`
-	PglookoutSettings types.String `tfsdk:"pglookout_settings"`
+	AdminPassword      types.String `tfsdk:"admin_password"`
+	AdminUsername      types.String `tfsdk:"admin_username"`
+	BackupSchedule     types.String `tfsdk:"backup_schedule"`
+	ForkFromService    types.String `tfsdk:"fork_from_service"`
+	IpFilter           types.Set    `tfsdk:"ip_filter"`
+	RecoveryBackupTime types.String `tfsdk:"recovery_backup_time"`
+	Settings           types.String `tfsdk:"pg_settings"`
+	Version            types.String `tfsdk:"version"`
+	PgbouncerSettings  types.String `tfsdk:"pgbouncer_settings"`
+	PglookoutSettings  types.String `tfsdk:"pglookout_settings"`
 }
 
 var ResourcePgSchema = schema.SingleNestedBlock{
@@ -47,6 +53,13 @@ var ResourcePgSchema = schema.SingleNestedBlock{
 			Optional:            true,
 			Computed:            true,
 		},
+		"fork_from_service": schema.StringAttribute{
+			MarkdownDescription: "❗ The name of the service to create this service as a fork of (e.g. to restore its backups into a new service).",
+			Optional:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
 		"ip_filter": schema.SetAttribute{
 			ElementType:         types.StringType,
 			MarkdownDescription: "A list of CIDR blocks to allow incoming connections from.",
@@ -56,6 +69,16 @@ var ResourcePgSchema = schema.SingleNestedBlock{
 				setvalidator.ValueStringsAre(validators.IsCIDRNetworkValidator{Min: 0, Max: 128}),
 			},
 		},
+		"recovery_backup_time": schema.StringAttribute{
+			MarkdownDescription: "❗ The point in time (ISO 8601, e.g. `2024-01-31T12:00:00Z`) of the `fork_from_service` backups to restore (default: latest backup).",
+			Optional:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+			Validators: []validator.String{
+				stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("fork_from_service")),
+			},
+		},
 		"pg_settings": schema.StringAttribute{
 			MarkdownDescription: "PostgreSQL configuration settings in JSON format (`exo dbaas type show pg --settings=pg` for reference).",
 			Optional:            true,
@@ -109,6 +132,14 @@ func (r *Resource) createPg(ctx context.Context, data *ResourceModel, diagnostic
 			service.AdminUsername = data.Pg.AdminUsername.ValueStringPointer()
 		}
 
+		if !data.Pg.ForkFromService.IsNull() {
+			service.ForkFromService = (*oapi.DbaasServiceName)(data.Pg.ForkFromService.ValueStringPointer())
+		}
+
+		if !data.Pg.RecoveryBackupTime.IsNull() {
+			service.RecoveryBackupTime = data.Pg.RecoveryBackupTime.ValueStringPointer()
+		}
+
 		if !data.Pg.IpFilter.IsUnknown() {
 			obj := []string{}
 			if len(data.Pg.IpFilter.Elements()) > 0 {
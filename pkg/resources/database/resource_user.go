@@ -0,0 +1,416 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	exoscale "github.com/exoscale/egoscale/v2"
+	exoapi "github.com/exoscale/egoscale/v2/api"
+	"github.com/exoscale/egoscale/v2/oapi"
+
+	"github.com/exoscale/terraform-provider-exoscale/pkg/config"
+	providerConfig "github.com/exoscale/terraform-provider-exoscale/pkg/provider/config"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ResourceUser{}
+var _ resource.ResourceWithImportState = &ResourceUser{}
+
+func NewResourceUser() resource.Resource {
+	return &ResourceUser{}
+}
+
+// ResourceUser defines the DBaaS Service user resource implementation.
+type ResourceUser struct {
+	client *exoscale.Client
+	env    string
+}
+
+// ResourceUserModel describes the DBaaS Service user resource data model.
+type ResourceUserModel struct {
+	Id               types.String `tfsdk:"id"`
+	AllowReplication types.Bool   `tfsdk:"allow_replication"`
+	Password         types.String `tfsdk:"password"`
+	Service          types.String `tfsdk:"service"`
+	Type             types.String `tfsdk:"type"`
+	Username         types.String `tfsdk:"username"`
+	Zone             types.String `tfsdk:"zone"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *ResourceUser) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_database_user"
+}
+
+func (r *ResourceUser) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manage users of an Exoscale [PostgreSQL Database Service](https://community.exoscale.com/documentation/dbaas/).",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of this resource (`<service>/<username>`).",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"allow_replication": schema.BoolAttribute{
+				MarkdownDescription: "Whether the user is allowed to use replication.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"password": schema.StringAttribute{
+				MarkdownDescription: "The password of the user.",
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"service": schema.StringAttribute{
+				MarkdownDescription: "❗ The name of the database service.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "The type of the user account (`primary` or `normal`).",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"username": schema.StringAttribute{
+				MarkdownDescription: "❗ The name of the user.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"zone": schema.StringAttribute{
+				MarkdownDescription: "❗ The Exoscale [Zone](https://www.exoscale.com/datacenters/) name.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(config.Zones...),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
+	}
+}
+
+func (r *ResourceUser) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*providerConfig.ExoscaleProviderConfig).ClientV2
+	r.env = req.ProviderData.(*providerConfig.ExoscaleProviderConfig).Environment
+}
+
+func (r *ResourceUser) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ResourceUserModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set timeout
+	t, diags := data.Timeouts.Create(ctx, config.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, t)
+	defer cancel()
+
+	ctx = exoapi.WithEndpoint(ctx, exoapi.NewReqEndpoint(r.env, data.Zone.ValueString()))
+
+	body := oapi.CreateDbaasPostgresUserJSONRequestBody{
+		Username: oapi.DbaasUserUsername(data.Username.ValueString()),
+	}
+	if !data.AllowReplication.IsUnknown() {
+		body.AllowReplication = data.AllowReplication.ValueBoolPointer()
+	}
+
+	res, err := r.client.CreateDbaasPostgresUserWithResponse(ctx, oapi.DbaasServiceName(data.Service.ValueString()), body)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create database user, got error: %s", err))
+		return
+	}
+	if res.StatusCode() != http.StatusOK {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create database user, unexpected status: %s", res.Status()))
+		return
+	}
+
+	data.Id = types.StringValue(fmt.Sprintf("%s/%s", data.Service.ValueString(), data.Username.ValueString()))
+
+	// User creation is asynchronous: wait for the user to be listed by the service.
+	_, err = oapi.NewPoller().
+		Poll(ctx, func(ctx context.Context) (bool, interface{}, error) {
+			found, err := r.read(ctx, &data)
+			return found, nil, err
+		})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read database user, got error: %s", err))
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	tflog.Trace(ctx, "resource created", map[string]interface{}{
+		"id": data.Id,
+	})
+}
+
+func (r *ResourceUser) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ResourceUserModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set timeout
+	t, diags := data.Timeouts.Read(ctx, config.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, t)
+	defer cancel()
+
+	ctx = exoapi.WithEndpoint(ctx, exoapi.NewReqEndpoint(r.env, data.Zone.ValueString()))
+
+	found, err := r.read(ctx, &data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read database user, got error: %s", err))
+		return
+	}
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	tflog.Trace(ctx, "resource read done", map[string]interface{}{
+		"id": data.Id,
+	})
+}
+
+func (r *ResourceUser) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var stateData, planData ResourceUserModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &planData)...)
+	// Read Terraform state data (for comparison) into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &stateData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set timeout
+	t, diags := stateData.Timeouts.Update(ctx, config.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, t)
+	defer cancel()
+
+	ctx = exoapi.WithEndpoint(ctx, exoapi.NewReqEndpoint(r.env, planData.Zone.ValueString()))
+
+	if !planData.AllowReplication.IsUnknown() && !planData.AllowReplication.Equal(stateData.AllowReplication) {
+		res, err := r.client.UpdateDbaasPostgresAllowReplicationWithResponse(
+			ctx,
+			oapi.DbaasServiceName(planData.Service.ValueString()),
+			oapi.DbaasUserUsername(planData.Username.ValueString()),
+			oapi.UpdateDbaasPostgresAllowReplicationJSONRequestBody{
+				AllowReplication: planData.AllowReplication.ValueBoolPointer(),
+			},
+		)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update database user, got error: %s", err))
+			return
+		}
+		if res.StatusCode() != http.StatusOK {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update database user, unexpected status: %s", res.Status()))
+			return
+		}
+	}
+
+	if _, err := r.read(ctx, &planData); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read database user, got error: %s", err))
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &planData)...)
+
+	tflog.Trace(ctx, "resource updated", map[string]interface{}{
+		"id": planData.Id,
+	})
+}
+
+func (r *ResourceUser) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ResourceUserModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set timeout
+	t, diags := data.Timeouts.Delete(ctx, config.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, t)
+	defer cancel()
+
+	ctx = exoapi.WithEndpoint(ctx, exoapi.NewReqEndpoint(r.env, data.Zone.ValueString()))
+
+	res, err := r.client.DeleteDbaasPostgresUserWithResponse(
+		ctx,
+		oapi.DbaasServiceName(data.Service.ValueString()),
+		oapi.DbaasUserUsername(data.Username.ValueString()),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete database user, got error: %s", err))
+		return
+	}
+	if res.StatusCode() != http.StatusOK {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete database user, unexpected status: %s", res.Status()))
+		return
+	}
+
+	tflog.Trace(ctx, "resource deleted", map[string]interface{}{
+		"id": data.Id,
+	})
+}
+
+func (r *ResourceUser) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	service, username, zone, err := parseServiceObjectImportID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: service/username@zone. Got: %q", req.ID),
+		)
+		return
+	}
+
+	var data ResourceUserModel
+
+	// Set timeouts (quirk https://github.com/hashicorp/terraform-plugin-framework-timeouts/issues/46)
+	var timeouts timeouts.Value
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("timeouts"), &timeouts)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Timeouts = timeouts
+
+	data.Id = types.StringValue(fmt.Sprintf("%s/%s", service, username))
+	data.Service = types.StringValue(service)
+	data.Username = types.StringValue(username)
+	data.Zone = types.StringValue(zone)
+
+	ctx = exoapi.WithEndpoint(ctx, exoapi.NewReqEndpoint(r.env, zone))
+
+	found, err := r.read(ctx, &data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read database user, got error: %s", err))
+		return
+	}
+	if !found {
+		resp.Diagnostics.AddError("Not Found", fmt.Sprintf("Database user %q not found", req.ID))
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	tflog.Trace(ctx, "resource imported", map[string]interface{}{
+		"id": data.Id,
+	})
+}
+
+// read fetches the user from the database service and updates the model accordingly.
+// It reports whether the user was found.
+func (r *ResourceUser) read(ctx context.Context, data *ResourceUserModel) (bool, error) {
+	res, err := r.client.GetDbaasServicePgWithResponse(ctx, oapi.DbaasServiceName(data.Service.ValueString()))
+	if err != nil {
+		return false, err
+	}
+	if res.StatusCode() != http.StatusOK {
+		return false, fmt.Errorf("unexpected status: %s", res.Status())
+	}
+
+	if res.JSON200.Users == nil {
+		return false, nil
+	}
+
+	for _, user := range *res.JSON200.Users {
+		if user.Username != data.Username.ValueString() {
+			continue
+		}
+
+		data.AllowReplication = types.BoolPointerValue(user.AllowReplication)
+		if user.AllowReplication == nil {
+			data.AllowReplication = types.BoolValue(false)
+		}
+		// A missing password indicates a user overridden password: keep the known value.
+		if user.Password != nil {
+			data.Password = types.StringPointerValue(user.Password)
+		} else if data.Password.IsUnknown() {
+			data.Password = types.StringNull()
+		}
+		data.Type = types.StringValue(user.Type)
+
+		return true, nil
+	}
+
+	return false, nil
+}
+
+// parseServiceObjectImportID parses an import identifier of the form
+// <service>/<object>@<zone> used by the database service sub-resources.
+func parseServiceObjectImportID(id string) (string, string, string, error) {
+	idParts := strings.Split(id, "@")
+	if len(idParts) != 2 || idParts[1] == "" {
+		return "", "", "", fmt.Errorf("invalid import identifier %q", id)
+	}
+
+	nameParts := strings.Split(idParts[0], "/")
+	if len(nameParts) != 2 || nameParts[0] == "" || nameParts[1] == "" {
+		return "", "", "", fmt.Errorf("invalid import identifier %q", id)
+	}
+
+	return nameParts[0], nameParts[1], idParts[1], nil
+}
@@ -0,0 +1,137 @@
+package database_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"text/template"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	exoapi "github.com/exoscale/egoscale/v2/api"
+	"github.com/exoscale/egoscale/v2/oapi"
+
+	"github.com/exoscale/terraform-provider-exoscale/pkg/testutils"
+)
+
+type TemplateModelUser struct {
+	ResourceName string
+
+	Service string
+	Plan    string
+	Zone    string
+
+	Username         string
+	AllowReplication bool
+}
+
+func testResourceUser(t *testing.T) {
+	tpl, err := template.ParseFiles("testdata/resource_user.tmpl")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fullResourceName := "exoscale_database_user.test"
+	dataBase := TemplateModelUser{
+		ResourceName: "test",
+		Service:      acctest.RandomWithPrefix(testutils.Prefix),
+		Plan:         "hobbyist-2",
+		Zone:         testutils.TestZoneName,
+		Username:     "app",
+	}
+
+	dataCreate := dataBase
+	buf := &bytes.Buffer{}
+	err = tpl.Execute(buf, &dataCreate)
+	if err != nil {
+		t.Fatal(err)
+	}
+	configCreate := buf.String()
+
+	dataUpdate := dataBase
+	dataUpdate.AllowReplication = true
+	buf = &bytes.Buffer{}
+	err = tpl.Execute(buf, &dataUpdate)
+	if err != nil {
+		t.Fatal(err)
+	}
+	configUpdate := buf.String()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutils.AccPreCheck(t) },
+		CheckDestroy:             CheckDestroy("pg", dataBase.Service),
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// Create
+				Config: configCreate,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(fullResourceName, "password"),
+					resource.TestCheckResourceAttr(fullResourceName, "type", "normal"),
+					resource.TestCheckResourceAttr(fullResourceName, "allow_replication", "false"),
+					func(s *terraform.State) error {
+						return CheckExistsUser(dataBase.Service, &dataCreate)
+					},
+				),
+			},
+			{
+				// Update
+				Config: configUpdate,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(fullResourceName, "allow_replication", "true"),
+					func(s *terraform.State) error {
+						return CheckExistsUser(dataBase.Service, &dataUpdate)
+					},
+				),
+			},
+			{
+				// Import
+				ResourceName: fullResourceName,
+				ImportStateIdFunc: func() resource.ImportStateIdFunc {
+					return func(*terraform.State) (string, error) {
+						return fmt.Sprintf("%s/%s@%s", dataBase.Service, dataBase.Username, dataBase.Zone), nil
+					}
+				}(),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func CheckExistsUser(service string, data *TemplateModelUser) error {
+	client, err := testutils.APIClient()
+	if err != nil {
+		return err
+	}
+
+	ctx := exoapi.WithEndpoint(context.Background(), exoapi.NewReqEndpoint(testutils.TestEnvironment(), testutils.TestZoneName))
+
+	res, err := client.GetDbaasServicePgWithResponse(ctx, oapi.DbaasServiceName(service))
+	if err != nil {
+		return err
+	}
+	if res.StatusCode() != http.StatusOK {
+		return fmt.Errorf("API request error: unexpected status %s", res.Status())
+	}
+
+	if res.JSON200.Users != nil {
+		for _, user := range *res.JSON200.Users {
+			if user.Username != data.Username {
+				continue
+			}
+
+			if v := user.AllowReplication != nil && *user.AllowReplication; v != data.AllowReplication {
+				return fmt.Errorf("allow_replication: expected %t, got %t", data.AllowReplication, v)
+			}
+
+			return nil
+		}
+	}
+
+	return fmt.Errorf("user %q not found", data.Username)
+}
@@ -0,0 +1,63 @@
+package database
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_validateSettings(t *testing.T) {
+	// Excerpt of the Kafka settings JSON Schema published by the API.
+	schema := map[string]interface{}{
+		"type":                 "object",
+		"additionalProperties": false,
+		"properties": map[string]interface{}{
+			"auto_create_topics_enable": map[string]interface{}{"type": "boolean"},
+			"num_partitions": map[string]interface{}{
+				"type":    "integer",
+				"minimum": 1,
+				"maximum": 1000,
+			},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		in      string
+		want    map[string]interface{}
+		wantErr string
+	}{
+		{
+			name: "valid",
+			in:   `{"auto_create_topics_enable": true, "num_partitions": 3}`,
+			want: map[string]interface{}{"auto_create_topics_enable": true, "num_partitions": float64(3)},
+		},
+		{
+			name:    "invalid JSON",
+			in:      `{"num_partitions": }`,
+			wantErr: "unable to unmarshal JSON",
+		},
+		{
+			name:    "out of range",
+			in:      `{"num_partitions": 0}`,
+			wantErr: "num_partitions: Must be greater than or equal to 1",
+		},
+		{
+			name:    "unknown setting",
+			in:      `{"num_replicas": 3}`,
+			wantErr: "Additional property num_replicas is not allowed",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := validateSettings(tt.in, schema)
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
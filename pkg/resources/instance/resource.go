@@ -158,12 +158,14 @@ func Resource() *schema.Resource {
 			Type:             schema.TypeString,
 			ValidateDiagFunc: utils.ValidateComputeUserData,
 			Optional:         true,
+			DiffSuppressFunc: utils.SuppressUserDataDiff,
 		},
 		AttrZone: {
-			Description: "The Exoscale [Zone](https://www.exoscale.com/datacenters/) name.",
-			Type:        schema.TypeString,
-			Required:    true,
-			ForceNew:    true,
+			Description:      "The Exoscale [Zone](https://www.exoscale.com/datacenters/) name.",
+			Type:             schema.TypeString,
+			Required:         true,
+			ForceNew:         true,
+			ValidateDiagFunc: utils.ValidateZone(),
 		},
 	}
 
@@ -181,6 +183,8 @@ func Resource() *schema.Resource {
 		UpdateContext: rUpdate,
 		DeleteContext: rDelete,
 
+		CustomizeDiff: utils.QuotaCustomizeDiff("instance"),
+
 		Importer: &schema.ResourceImporter{
 			StateContext: utils.ZonedStateContextFunc,
 		},
@@ -4,30 +4,43 @@ const (
 	Name     = "exoscale_instance_pool"
 	NameList = "exoscale_instance_pool_list"
 
-	AttrAffinityGroupIDs        = "affinity_group_ids"
-	AttrDeployTargetID          = "deploy_target_id"
-	AttrDescription             = "description"
-	AttrDiskSize                = "disk_size"
-	AttrElasticIPIDs            = "elastic_ip_ids"
-	AttrInstancePrefix          = "instance_prefix"
-	AttrInstanceType            = "instance_type"
-	AttrIPv6                    = "ipv6"
-	AttrKeyPair                 = "key_pair"
-	AttrLabels                  = "labels"
-	AttrID                      = "id"
-	AttrName                    = "name"
-	AttrNetworkIDs              = "network_ids"
-	AttrServiceOffering         = "service_offering"
-	AttrSecurityGroupIDs        = "security_group_ids"
-	AttrSize                    = "size"
-	AttrState                   = "state"
-	AttrTemplateID              = "template_id"
-	AttrUserData                = "user_data"
-	AttrInstances               = "instances"
-	AttrInstanceID              = "id"
-	AttrInstanceIPv6Address     = "ipv6_address"
-	AttrInstanceName            = "name"
-	AttrInstancePublicIPAddress = "public_ip_address"
-	AttrVirtualMachines         = "virtual_machines"
-	AttrZone                    = "zone"
+	AttrAffinityGroupIDs             = "affinity_group_ids"
+	AttrDeletionProtection           = "deletion_protection"
+	AttrDeployTargetID               = "deploy_target_id"
+	AttrDescription                  = "description"
+	AttrDiskSize                     = "disk_size"
+	AttrElasticIPIDs                 = "elastic_ip_ids"
+	AttrExternallyManagedSize        = "externally_managed_size"
+	AttrInstanceNameTemplate         = "instance_name_template"
+	AttrInstancesNotMatchingTemplate = "instances_not_matching_template"
+	AttrInstancePrefix               = "instance_prefix"
+	AttrInstanceType                 = "instance_type"
+	AttrIPv6                         = "ipv6"
+	AttrKeyPair                      = "key_pair"
+	AttrLabels                       = "labels"
+	AttrManagerID                    = "manager_id"
+	AttrManagerType                  = "manager_type"
+	AttrMinAvailable                 = "min_available"
+	AttrID                           = "id"
+	AttrName                         = "name"
+	AttrNetworkIDs                   = "network_ids"
+	AttrNLBServiceIDs                = "nlb_service_ids"
+	AttrRecreateOnUserDataChange     = "recreate_on_user_data_change"
+	AttrRollingReplace               = "rolling_replace"
+	AttrScaleInProtection            = "scale_in_protection"
+	AttrServiceOffering              = "service_offering"
+	AttrSecurityGroupIDs             = "security_group_ids"
+	AttrSize                         = "size"
+	AttrSSHKeys                      = "ssh_keys"
+	AttrState                        = "state"
+	AttrTemplateID                   = "template_id"
+	AttrTemplateName                 = "template_name"
+	AttrUserData                     = "user_data"
+	AttrInstances                    = "instances"
+	AttrInstanceID                   = "id"
+	AttrInstanceIPv6Address          = "ipv6_address"
+	AttrInstanceName                 = "name"
+	AttrInstancePublicIPAddress      = "public_ip_address"
+	AttrVirtualMachines              = "virtual_machines"
+	AttrZone                         = "zone"
 )
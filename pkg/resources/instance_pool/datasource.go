@@ -73,6 +73,16 @@ func DataSourceSchema() map[string]*schema.Schema {
 			Elem:        &schema.Schema{Type: schema.TypeString},
 			Optional:    true,
 		},
+		AttrManagerID: {
+			Description: "The instance pool manager ID, if any.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		AttrManagerType: {
+			Description: "The instance pool manager type (e.g. `sks-nodepool` for pools managed by an [exoscale_sks_nodepool](../resources/sks_nodepool.md)), if any.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
 		AttrName: {
 			Description: "The pool name to match (conflicts with `id`).",
 			Type:        schema.TypeString,
@@ -129,7 +139,7 @@ func DataSourceSchema() map[string]*schema.Schema {
 						Optional:    true,
 					},
 					AttrInstanceIPv6Address: {
-						Description: "The instance (main network interface) IPv6 address.",
+						Description: "The instance (main network interface) IPv6 address (if enabled).",
 						Type:        schema.TypeString,
 						Computed:    true,
 					},
@@ -160,12 +170,32 @@ func DataSource() *schema.Resource {
 
 Corresponding resource: [exoscale_instance_pool](../resources/instance_pool.md).`,
 		Schema: func() map[string]*schema.Schema {
-			schema := DataSourceSchema()
+			s := DataSourceSchema()
 
 			// adding context-aware schema settings here so getDataSourceInstancePoolSchema can be used in list method
-			schema[AttrID].ConflictsWith = []string{AttrName}
-			schema[AttrName].ConflictsWith = []string{AttrID}
-			return schema
+			s[AttrID].ConflictsWith = []string{AttrName}
+			s[AttrName].ConflictsWith = []string{AttrID}
+
+			// Labels are reported on lookup by ID, and narrow the lookup by name.
+			s[AttrLabels] = &schema.Schema{
+				Description:   "A map of key/value labels. When looking up a pool by `name`, only match the pool having all these labels (conflicts with `id`).",
+				Type:          schema.TypeMap,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{AttrID},
+			}
+
+			// NLB services are cross-referenced on single pool lookup only, as it requires listing
+			// all the NLBs of the zone.
+			s[AttrNLBServiceIDs] = &schema.Schema{
+				Description: "The list of [exoscale_nlb_service](../resources/nlb_service.md) (IDs) forwarding traffic to the instance pool.",
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Set:         schema.HashString,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			}
+			return s
 		}(),
 		ReadContext: dsRead,
 	}
@@ -197,15 +227,20 @@ func dsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.
 		)
 	}
 
-	pool, err := client.FindInstancePool(
-		ctx,
-		zone, func() string {
-			if byID {
-				return id.(string)
-			}
-			return name.(string)
-		}(),
-	)
+	var pool *exo.InstancePool
+	if labels, byLabels := d.GetOk(AttrLabels); byName && byLabels {
+		pool, err = dsFindByNameAndLabels(ctx, client, zone, name.(string), labels.(map[string]interface{}))
+	} else {
+		pool, err = client.FindInstancePool(
+			ctx,
+			zone, func() string {
+				if byID {
+					return id.(string)
+				}
+				return name.(string)
+			}(),
+		)
+	}
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -233,32 +268,20 @@ func dsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.
 	)
 
 	if pool.InstanceIDs != nil {
-		instancesData := make([]interface{}, len(*pool.InstanceIDs))
-		for i, id := range *pool.InstanceIDs {
-			instance, err := client.GetInstance(ctx, zone, id)
-			if err != nil {
-				return diag.FromErr(err)
-			}
-
-			var ipv6, publicIp string
-			if instance.IPv6Address != nil {
-				ipv6 = instance.IPv6Address.String()
-			}
-			if instance.PublicIPAddress != nil {
-				publicIp = instance.PublicIPAddress.String()
-			}
-
-			instancesData[i] = map[string]interface{}{
-				AttrInstanceID:              id,
-				AttrInstanceIPv6Address:     ipv6,
-				AttrInstanceName:            instance.Name,
-				AttrInstancePublicIPAddress: publicIp,
-			}
+		instancesData, err := dsBuildInstancesData(ctx, client, zone, *pool.InstanceIDs)
+		if err != nil {
+			return diag.FromErr(err)
 		}
 
 		data[AttrInstances] = instancesData
 	}
 
+	nlbServiceIDs, err := dsFindNLBServiceIDs(ctx, client, zone, *pool.ID)
+	if err != nil {
+		return diag.Errorf("error retrieving NLB services: %s", err)
+	}
+	data[AttrNLBServiceIDs] = nlbServiceIDs
+
 	for key, value := range data {
 		err := d.Set(key, value)
 		if err != nil {
@@ -273,6 +296,98 @@ func dsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.
 	return nil
 }
 
+// dsFindByNameAndLabels returns the instance pool of the zone named name and having all the labels,
+// failing with utils.ErrTooManyFound if several pools match.
+func dsFindByNameAndLabels(
+	ctx context.Context,
+	client *exo.Client,
+	zone, name string,
+	labels map[string]interface{},
+) (*exo.InstancePool, error) {
+	pools, err := client.ListInstancePools(ctx, zone)
+	if err != nil {
+		return nil, err
+	}
+
+	var id string
+	for _, pool := range pools {
+		if pool.ID == nil || utils.DefaultString(pool.Name, "") != name || !dsMatchLabels(pool.Labels, labels) {
+			continue
+		}
+		if id != "" {
+			return nil, utils.TooManyFoundError("instance pools", name)
+		}
+		id = *pool.ID
+	}
+	if id == "" {
+		return nil, exoapi.ErrNotFound
+	}
+
+	return client.GetInstancePool(ctx, zone, id)
+}
+
+// dsMatchLabels reports whether poolLabels (possibly nil) contain all the labels.
+func dsMatchLabels(poolLabels *map[string]string, labels map[string]interface{}) bool {
+	for k, v := range labels {
+		if poolLabels == nil {
+			return false
+		}
+		if pv, ok := (*poolLabels)[k]; !ok || pv != v.(string) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// dsFindNLBServiceIDs returns the IDs of the NLB services of the zone forwarding traffic to the instance pool.
+// dsBuildInstancesData returns the managed instances data, fetching the addresses
+// from each instance details as they are not reported by the instance pool endpoints.
+func dsBuildInstancesData(ctx context.Context, client *exo.Client, zone string, ids []string) ([]interface{}, error) {
+	instancesData := make([]interface{}, len(ids))
+	for i, id := range ids {
+		instance, err := client.GetInstance(ctx, zone, id)
+		if err != nil {
+			return nil, fmt.Errorf("unable to retrieve instance %q: %w", id, err)
+		}
+
+		var ipv6, publicIp string
+		if instance.IPv6Address != nil {
+			ipv6 = instance.IPv6Address.String()
+		}
+		if instance.PublicIPAddress != nil {
+			publicIp = instance.PublicIPAddress.String()
+		}
+
+		instancesData[i] = map[string]interface{}{
+			AttrInstanceID:              id,
+			AttrInstanceIPv6Address:     ipv6,
+			AttrInstanceName:            instance.Name,
+			AttrInstancePublicIPAddress: publicIp,
+		}
+	}
+
+	return instancesData, nil
+}
+
+func dsFindNLBServiceIDs(ctx context.Context, client *exo.Client, zone, poolID string) ([]string, error) {
+	nlbs, err := client.ListNetworkLoadBalancers(ctx, zone)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, 0)
+	for _, nlb := range nlbs {
+		for _, service := range nlb.Services {
+			if service.ID != nil && utils.DefaultString(service.InstancePoolID, "") == poolID {
+				ids = append(ids, *service.ID)
+			}
+		}
+	}
+
+	return ids, nil
+}
+
 // dsBuildData builds terraform data object from egoscale API struct.
 func dsBuildData(pool *exo.InstancePool) (map[string]interface{}, error) {
 	data := map[string]interface{}{}
@@ -294,6 +409,12 @@ func dsBuildData(pool *exo.InstancePool) (map[string]interface{}, error) {
 		data[AttrAffinityGroupIDs] = *pool.AntiAffinityGroupIDs
 	}
 
+	if pool.Manager != nil {
+		data[AttrManagerID] = pool.Manager.ID
+		data[AttrManagerType] = pool.Manager.Type
+	}
+
+	data[AttrLabels] = map[string]string{}
 	if pool.Labels != nil {
 		data[AttrLabels] = *pool.Labels
 	}
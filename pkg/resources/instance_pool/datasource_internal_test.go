@@ -0,0 +1,34 @@
+package instance_pool
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	egoscale "github.com/exoscale/egoscale/v2"
+)
+
+func Test_dsMatchLabels(t *testing.T) {
+	poolLabels := &map[string]string{"env": "prod", "team": "web"}
+
+	require.True(t, dsMatchLabels(poolLabels, nil))
+	require.True(t, dsMatchLabels(poolLabels, map[string]interface{}{"env": "prod"}))
+	require.True(t, dsMatchLabels(poolLabels, map[string]interface{}{"env": "prod", "team": "web"}))
+	require.False(t, dsMatchLabels(poolLabels, map[string]interface{}{"env": "staging"}))
+	require.False(t, dsMatchLabels(poolLabels, map[string]interface{}{"owner": "ops"}))
+
+	require.True(t, dsMatchLabels(nil, nil))
+	require.True(t, dsMatchLabels(&map[string]string{}, map[string]interface{}{}))
+	require.False(t, dsMatchLabels(nil, map[string]interface{}{"env": "prod"}))
+	require.False(t, dsMatchLabels(&map[string]string{}, map[string]interface{}{"env": "prod"}))
+}
+
+func Test_dsBuildDataLabels(t *testing.T) {
+	data, err := dsBuildData(&egoscale.InstancePool{})
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{}, data[AttrLabels])
+
+	data, err = dsBuildData(&egoscale.InstancePool{Labels: &map[string]string{"env": "prod"}})
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"env": "prod"}, data[AttrLabels])
+}
@@ -114,27 +114,9 @@ func dsListRead(ctx context.Context, d *schema.ResourceData, meta interface{}) d
 		}
 
 		if pool.InstanceIDs != nil {
-			instancesData := make([]interface{}, len(*pool.InstanceIDs))
-			for i, id := range *pool.InstanceIDs {
-				instance, err := client.GetInstance(ctx, zone, id)
-				if err != nil {
-					return diag.FromErr(err)
-				}
-
-				var ipv6, publicIp string
-				if instance.IPv6Address != nil {
-					ipv6 = instance.IPv6Address.String()
-				}
-				if instance.PublicIPAddress != nil {
-					publicIp = instance.PublicIPAddress.String()
-				}
-
-				instancesData[i] = map[string]interface{}{
-					AttrInstanceID:              id,
-					AttrInstanceIPv6Address:     ipv6,
-					AttrInstanceName:            instance.Name,
-					AttrInstancePublicIPAddress: publicIp,
-				}
+			instancesData, err := dsBuildInstancesData(ctx, client, zone, *pool.InstanceIDs)
+			if err != nil {
+				return diag.FromErr(err)
 			}
 
 			poolData[AttrInstances] = instancesData
@@ -91,11 +91,19 @@ data "exoscale_instance_pool_list" "test" {
 				),
 				Check: resource.ComposeTestCheckFunc(
 					dsCheckListAttrs("data.exoscale_instance_pool_list.test", testutils.TestAttrs{
-						"pools.#":             testutils.ValidateString("2"),
-						"pools.0.id":          validation.ToDiagFunc(validation.NoZeroValues),
-						"pools.0.instances.#": testutils.ValidateString("1"),
-						"pools.1.id":          validation.ToDiagFunc(validation.NoZeroValues),
-						"pools.1.instances.#": testutils.ValidateString("1"),
+						"pools.#":                  testutils.ValidateString("2"),
+						"pools.0.id":               validation.ToDiagFunc(validation.NoZeroValues),
+						"pools.0.deploy_target_id": testutils.ValidateString(""),
+						"pools.0.instance_type":    testutils.ValidateString(dsListInstanceType),
+						"pools.0.instances.#":      testutils.ValidateString("1"),
+						"pools.0.size":             testutils.ValidateString("1"),
+						"pools.0.state":            validation.ToDiagFunc(validation.NoZeroValues),
+						"pools.1.id":               validation.ToDiagFunc(validation.NoZeroValues),
+						"pools.1.deploy_target_id": testutils.ValidateString(""),
+						"pools.1.instance_type":    testutils.ValidateString(dsListInstanceType),
+						"pools.1.instances.#":      testutils.ValidateString("1"),
+						"pools.1.size":             testutils.ValidateString("1"),
+						"pools.1.state":            validation.ToDiagFunc(validation.NoZeroValues),
 					}),
 				),
 			},
@@ -25,6 +25,8 @@ var (
 	dsLabelValue        = acctest.RandomWithPrefix(testutils.Prefix)
 	dsNetwork           = acctest.RandomWithPrefix(testutils.Prefix)
 	dsName              = acctest.RandomWithPrefix(testutils.Prefix)
+	dsNLBName           = acctest.RandomWithPrefix(testutils.Prefix)
+	dsNLBServiceName    = acctest.RandomWithPrefix(testutils.Prefix)
 	dsSize              = "2"
 	dsTemplateName      = testutils.TestInstanceTemplateName
 	dsUserData          = acctest.RandString(10)
@@ -72,7 +74,7 @@ resource "exoscale_instance_pool" "test" {
   instance_prefix    = "%s"
   size               = %s
   disk_size          = %s
-  ipv6               = false
+  ipv6               = true
   key_pair           = exoscale_ssh_keypair.test.name
   affinity_group_ids = [exoscale_affinity.test.id]
   network_ids        = [exoscale_network.test.id]
@@ -83,9 +85,40 @@ resource "exoscale_instance_pool" "test" {
   }
 }
 
+resource "exoscale_nlb" "test" {
+  zone = local.zone
+  name = "%s"
+}
+
+resource "exoscale_nlb_service" "test" {
+  zone             = local.zone
+  name             = "%s"
+  nlb_id           = exoscale_nlb.test.id
+  instance_pool_id = exoscale_instance_pool.test.id
+  port             = 80
+  target_port      = 8080
+
+  healthcheck {
+    port = 8080
+  }
+}
+
 data "exoscale_instance_pool" "by-id" {
   zone = exoscale_instance_pool.test.zone
   id   = exoscale_instance_pool.test.id
+
+  depends_on = [exoscale_nlb_service.test]
+}
+
+data "exoscale_instance_pool" "by-name-labels" {
+  zone = exoscale_instance_pool.test.zone
+  name = exoscale_instance_pool.test.name
+
+  labels = {
+    test = "%s"
+  }
+
+  depends_on = [exoscale_nlb_service.test]
 }`,
 					testutils.TestZoneName,
 					dsTemplateName,
@@ -100,29 +133,39 @@ data "exoscale_instance_pool" "by-id" {
 					dsDiskSize,
 					dsUserData,
 					dsLabelValue,
+					dsNLBName,
+					dsNLBServiceName,
+					dsLabelValue,
 				),
 				Check: resource.ComposeTestCheckFunc(
+					dsCheckAttrs("data.exoscale_instance_pool.by-name-labels", testutils.TestAttrs{
+						"id":          validation.ToDiagFunc(validation.IsUUID),
+						"labels.test": testutils.ValidateString(dsLabelValue),
+						"name":        testutils.ValidateString(dsName),
+					}),
 					dsCheckAttrs("data.exoscale_instance_pool.by-id", testutils.TestAttrs{
-						"affinity_group_ids.#": testutils.ValidateString("1"),
-						"affinity_group_ids.0": validation.ToDiagFunc(validation.IsUUID),
-						"description":          testutils.ValidateString(dsDescription),
-						"disk_size":            testutils.ValidateString(dsDiskSize),
-						"instance_type":        utils.ValidateComputeInstanceType,
-						"instance_prefix":      testutils.ValidateString(dsInstancePrefix),
-						"key_pair":             testutils.ValidateString(dsKeyPair),
-						"labels.test":          testutils.ValidateString(dsLabelValue),
-						"id":                   validation.ToDiagFunc(validation.IsUUID),
-						"name":                 testutils.ValidateString(dsName),
-						"network_ids.#":        testutils.ValidateString("1"),
-						"network_ids.0":        validation.ToDiagFunc(validation.IsUUID),
-						"size":                 testutils.ValidateString(dsSize),
-						// NOTE: state is unreliable atm, improvement suggested in 54808
-						// "state":                testutils.ValidateString("running"),
-						"template_id":    validation.ToDiagFunc(validation.IsUUID),
-						"user_data":      testutils.ValidateString(dsUserData),
-						"instances.#":    testutils.ValidateString("2"),
-						"instances.0.id": validation.ToDiagFunc(validation.IsUUID),
-						"instances.1.id": validation.ToDiagFunc(validation.IsUUID),
+						"affinity_group_ids.#":     testutils.ValidateString("1"),
+						"affinity_group_ids.0":     validation.ToDiagFunc(validation.IsUUID),
+						"description":              testutils.ValidateString(dsDescription),
+						"disk_size":                testutils.ValidateString(dsDiskSize),
+						"instance_type":            utils.ValidateComputeInstanceType,
+						"instance_prefix":          testutils.ValidateString(dsInstancePrefix),
+						"key_pair":                 testutils.ValidateString(dsKeyPair),
+						"labels.test":              testutils.ValidateString(dsLabelValue),
+						"id":                       validation.ToDiagFunc(validation.IsUUID),
+						"name":                     testutils.ValidateString(dsName),
+						"network_ids.#":            testutils.ValidateString("1"),
+						"network_ids.0":            validation.ToDiagFunc(validation.IsUUID),
+						"nlb_service_ids.#":        testutils.ValidateString("1"),
+						"size":                     testutils.ValidateString(dsSize),
+						"state":                    testutils.ValidateString("running"),
+						"template_id":              validation.ToDiagFunc(validation.IsUUID),
+						"user_data":                testutils.ValidateString(dsUserData),
+						"instances.#":              testutils.ValidateString("2"),
+						"instances.0.id":           validation.ToDiagFunc(validation.IsUUID),
+						"instances.1.id":           validation.ToDiagFunc(validation.IsUUID),
+						"instances.0.ipv6_address": validation.ToDiagFunc(validation.IsIPv6Address),
+						"instances.1.ipv6_address": validation.ToDiagFunc(validation.IsIPv6Address),
 					}),
 				),
 			},
@@ -6,4 +6,12 @@ func TestInstancePool(t *testing.T) {
 	t.Run("DataSource", testDataSource)
 	t.Run("DataSourceList", testListDataSource)
 	t.Run("Resource", testResource)
+	t.Run("ResourceIPv6Drift", testResourceIPv6Drift)
+	t.Run("ResourceTemplateName", testResourceTemplateName)
+	t.Run("ResourceAntiAffinityGroups", testResourceAntiAffinityGroups)
+	t.Run("ResourceRecreateOnUserDataChange", testResourceRecreateOnUserDataChange)
+	t.Run("ResourceSecurityGroupsNotFound", testResourceSecurityGroupsNotFound)
+	t.Run("ResourceExternallyManagedSize", testResourceExternallyManagedSize)
+	t.Run("ResourceSSHKeys", testResourceSSHKeys)
+	t.Run("ResourceDeletionProtection", testResourceDeletionProtection)
 }
@@ -4,8 +4,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"regexp"
+	"strconv"
 	"strings"
+	"time"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -13,6 +17,7 @@ import (
 
 	egoscale "github.com/exoscale/egoscale/v2"
 	exoapi "github.com/exoscale/egoscale/v2/api"
+	"github.com/exoscale/egoscale/v2/oapi"
 
 	"github.com/exoscale/terraform-provider-exoscale/pkg/config"
 	"github.com/exoscale/terraform-provider-exoscale/pkg/utils"
@@ -22,10 +27,14 @@ const (
 	DefaultInstancePrefix = "pool"
 )
 
+// statePollInterval is the interval between instance pool state checks
+// while waiting for the pool to settle.
+var statePollInterval = 5 * time.Second
+
 func Resource() *schema.Resource {
 	s := map[string]*schema.Schema{
 		AttrAffinityGroupIDs: {
-			Description: "A list of [exoscale_anti_affinity_group](./anti_affinity_group.md) (IDs; may only be set at creation time).",
+			Description: "A list of [exoscale_anti_affinity_group](./anti_affinity_group.md) (IDs). Changes only apply to instances created afterwards (e.g. when scaling up): existing members keep their placement.",
 			Type:        schema.TypeSet,
 			Optional:    true,
 			Set:         schema.HashString,
@@ -36,13 +45,19 @@ func Resource() *schema.Resource {
 			Type:        schema.TypeString,
 			Optional:    true,
 		},
+		AttrDeletionProtection: {
+			Description: "Prevent the pool (and its managed instances, along with their local data) from being destroyed, e.g. for pools backing stateful workloads. It must be set to `false` in a prior apply before the pool can be deleted (boolean; default: `false`).",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
 		AttrDescription: {
 			Description: "A free-form text describing the pool.",
 			Type:        schema.TypeString,
 			Optional:    true,
 		},
 		AttrDiskSize: {
-			Description: "The managed instances disk size (GiB).",
+			Description: "The managed instances disk size (GiB). Existing managed instances disk is not resized live (unsupported by the platform): only new instances use the updated size, unless `rolling_replace` is set.",
 			Type:        schema.TypeInt,
 			Computed:    true,
 			Optional:    true,
@@ -54,11 +69,33 @@ func Resource() *schema.Resource {
 			Set:         schema.HashString,
 			Elem:        &schema.Schema{Type: schema.TypeString},
 		},
-		AttrInstancePrefix: {
-			Description: "The string used to prefix managed instances name (default: `pool`).",
+		AttrExternallyManagedSize: {
+			Description: "Leave the pool size to an external autoscaler: `size` is then only used at creation time, and size changes made outside of Terraform are neither planned nor reverted (default: `false`).",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
+		AttrInstanceNameTemplate: {
+			Description: "A template to rename managed instances after their creation, supporting the `%d` (instance index within the pool, starting at `1`) and `%z` (zone) tokens, e.g. `web-%z-%d`. The template must contain `%d` and render to a valid hostname. Instances keep their name as long as it matches the template; instances not named after it (e.g. added by an autoscaler) are reported in `instances_not_matching_template` on refresh, and renamed on the next apply.",
 			Type:        schema.TypeString,
 			Optional:    true,
-			Default:     DefaultInstancePrefix,
+			ValidateDiagFunc: func(i interface{}, _ cty.Path) diag.Diagnostics {
+				return diag.FromErr(validateInstanceNameTemplate(i.(string)))
+			},
+		},
+		AttrInstancesNotMatchingTemplate: {
+			Description: "The managed instances (IDs) not named after `instance_name_template`, to be renamed on the next apply.",
+			Type:        schema.TypeSet,
+			Computed:    true,
+			Set:         schema.HashString,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+		AttrInstancePrefix: {
+			Description:      fmt.Sprintf("The string used to prefix managed instances name (letters, digits and hyphens; at most %d characters; default: `pool`).", utils.InstancePrefixMaxLength),
+			Type:             schema.TypeString,
+			Optional:         true,
+			Default:          DefaultInstancePrefix,
+			ValidateDiagFunc: utils.ValidateInstancePrefix,
 		},
 		AttrInstanceType: {
 			// TODO: as long as "service_offering" is still deprecated but supported,
@@ -82,9 +119,11 @@ func Resource() *schema.Resource {
 			Default:     false,
 		},
 		AttrKeyPair: {
-			Description: "The [exoscale_ssh_key](./ssh_key.md) (name) to authorize in the managed instances.",
-			Type:        schema.TypeString,
-			Optional:    true,
+			Description:   "The [exoscale_ssh_key](./ssh_key.md) (name) to authorize in the managed instances. Please use the `ssh_keys` argument instead.",
+			Type:          schema.TypeString,
+			Optional:      true,
+			Deprecated:    "Use ssh_keys instead.",
+			ConflictsWith: []string{AttrSSHKeys},
 		},
 		AttrLabels: {
 			Description: "A map of key/value labels.",
@@ -92,6 +131,16 @@ func Resource() *schema.Resource {
 			Elem:        &schema.Schema{Type: schema.TypeString},
 			Optional:    true,
 		},
+		AttrManagerID: {
+			Description: "The instance pool manager ID, if any.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		AttrManagerType: {
+			Description: "The instance pool manager type (e.g. `sks-nodepool`), if any. Instance pools having a manager can't be managed with this resource.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
 		AttrName: {
 			Description: "The instance pool name.",
 			Type:        schema.TypeString,
@@ -120,27 +169,55 @@ func Resource() *schema.Resource {
 			ConflictsWith: []string{AttrInstanceType},
 			ValidateFunc:  utils.ValidateLowercaseString,
 		},
+		AttrScaleInProtection: {
+			Description: "A list of managed instances (IDs) to keep when reducing `size`: the other members are evicted instead of letting the platform choose which instances to remove.",
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Set:         schema.HashString,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
 		AttrSize: {
 			Description:  "The number of managed instances.",
 			Type:         schema.TypeInt,
 			Required:     true,
 			ValidateFunc: validation.IntAtLeast(1),
+			// Once created, the size of externally managed pools is left to the external autoscaler.
+			DiffSuppressFunc: func(_, _, _ string, d *schema.ResourceData) bool {
+				return d.Id() != "" && d.Get(AttrExternallyManagedSize).(bool)
+			},
+		},
+		AttrSSHKeys: {
+			Description:   "A list of [exoscale_ssh_key](./ssh_key.md) (names) to authorize in the managed instances.",
+			Type:          schema.TypeSet,
+			Optional:      true,
+			Set:           schema.HashString,
+			Elem:          &schema.Schema{Type: schema.TypeString},
+			ConflictsWith: []string{AttrKeyPair},
 		},
 		AttrState: {
-			Type:     schema.TypeString,
-			Optional: true,
-			Computed: true,
+			Description: "The instance pool state.",
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
 		},
 		AttrTemplateID: {
-			Description: "The [exoscale_compute_template](../data-sources/compute_template.md) (ID) to use when creating the managed instances.",
-			Type:        schema.TypeString,
-			Required:    true,
+			Description:  "The [exoscale_compute_template](../data-sources/compute_template.md) (ID) to use when creating the managed instances (conflicts with `template_name`).",
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ExactlyOneOf: []string{AttrTemplateID, AttrTemplateName},
 		},
-		AttrUserData: {
-			Description: "[cloud-init](http://cloudinit.readthedocs.io/) configuration to apply to the managed instances.",
+		AttrTemplateName: {
+			Description: "The name of the template to use when creating the managed instances, resolved to the newest matching public (or else private) template of the pool zone (conflicts with `template_id`).",
 			Type:        schema.TypeString,
 			Optional:    true,
 		},
+		AttrUserData: {
+			Description:      "[cloud-init](http://cloudinit.readthedocs.io/) configuration to apply to the managed instances.",
+			Type:             schema.TypeString,
+			Optional:         true,
+			DiffSuppressFunc: utils.SuppressUserDataDiff,
+		},
 		AttrVirtualMachines: {
 			Description: "The list of managed instances (IDs). Please use the `instances.*.id` attribute instead.",
 			Type:        schema.TypeSet,
@@ -162,7 +239,7 @@ func Resource() *schema.Resource {
 						Optional: true,
 					},
 					AttrInstanceIPv6Address: {
-						Description: "The instance (main network interface) IPv6 address.",
+						Description: "The instance (main network interface) IPv6 address (if enabled).",
 						Type:        schema.TypeString,
 						Computed:    true,
 					},
@@ -179,11 +256,30 @@ func Resource() *schema.Resource {
 				},
 			},
 		},
+		AttrMinAvailable: {
+			Description:  "The minimum number of managed instances to keep running while replacing them with `rolling_replace` or `recreate_on_user_data_change` (default: `size` - 1).",
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+		},
+		AttrRecreateOnUserDataChange: {
+			Description: "Replace existing managed instances in batches (honoring `min_available`) when `user_data` is updated, as cloud-init only runs when an instance is created (boolean; default: `false`). Note that replaced instances lose their local data and get new IP addresses.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
+		AttrRollingReplace: {
+			Description: "Replace existing managed instances in batches (honoring `min_available`) when `disk_size` is updated (boolean; default: `false`).",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
 		AttrZone: {
-			Description: "The Exoscale [Zone](https://www.exoscale.com/datacenters/) name.",
-			Type:        schema.TypeString,
-			Required:    true,
-			ForceNew:    true,
+			Description:      "The Exoscale [Zone](https://www.exoscale.com/datacenters/) name. Instance pools span a single zone: declare one pool per zone (e.g. using `for_each`) to spread managed instances across zones.",
+			Type:             schema.TypeString,
+			Required:         true,
+			ForceNew:         true,
+			ValidateDiagFunc: utils.ValidateZone(),
 		},
 	}
 
@@ -198,8 +294,10 @@ Corresponding data sources: [exoscale_instance_pool](../data-sources/instance_po
 		UpdateContext: rUpdate,
 		DeleteContext: rDelete,
 
+		CustomizeDiff: rCustomizeDiff,
+
 		Importer: &schema.ResourceImporter{
-			StateContext: utils.ZonedStateContextFunc,
+			StateContext: rImport,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -211,6 +309,105 @@ Corresponding data sources: [exoscale_instance_pool](../data-sources/instance_po
 	}
 }
 
+// rReplacementImpacts describes the impact of replacing an instance pool
+// following a change of one of its ForceNew attributes.
+var rReplacementImpacts = map[string]string{
+	AttrZone: "the instance pool and all its managed instances (including their local data and public IP addresses) are deleted, and new ones are created in the new zone",
+}
+
+func rCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	utils.WarnReplacement(ctx, d, rReplacementImpacts)
+
+	// The template ID will be resolved from the template name during apply.
+	if d.HasChange(AttrTemplateName) && d.Get(AttrTemplateName).(string) != "" {
+		if err := d.SetNewComputed(AttrTemplateID); err != nil {
+			return err
+		}
+	}
+
+	// Managed instances not named after the template are renamed during apply.
+	if d.Id() != "" && d.Get(AttrInstanceNameTemplate).(string) != "" &&
+		d.Get(AttrInstancesNotMatchingTemplate).(*schema.Set).Len() > 0 {
+		if err := d.SetNewComputed(AttrInstancesNotMatchingTemplate); err != nil {
+			return err
+		}
+	}
+
+	if err := utils.CheckQuotaDiff(ctx, d, meta, "instance", rRequestedInstances(d)); err != nil {
+		return err
+	}
+
+	return rValidateZonalReferences(ctx, d, meta)
+}
+
+// rRequestedInstances returns the number of managed instances the planned change
+// adds to the instance pool, i.e. its size when created or its size increase.
+func rRequestedInstances(d interface {
+	Id() string
+	GetChange(string) (interface{}, interface{})
+}) int64 {
+	o, n := d.GetChange(AttrSize)
+	if d.Id() == "" {
+		return int64(n.(int))
+	}
+
+	return int64(n.(int) - o.(int))
+}
+
+// rValidateZonalReferences ensures that the referenced private networks and template
+// exist in the instance pool zone, as the API otherwise fails with a confusing error
+// at apply time. Values only known at apply time are not checked.
+func rValidateZonalReferences(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	checkNetworks := d.HasChange(AttrNetworkIDs) && d.NewValueKnown(AttrNetworkIDs)
+	checkTemplate := d.HasChange(AttrTemplateID) && d.NewValueKnown(AttrTemplateID) &&
+		d.Get(AttrTemplateID).(string) != ""
+	if !d.NewValueKnown(AttrZone) || (!checkNetworks && !checkTemplate) {
+		return nil
+	}
+
+	zone := d.Get(AttrZone).(string)
+
+	ctx = exoapi.WithEndpoint(ctx, exoapi.NewReqEndpoint(config.GetEnvironment(meta), zone))
+
+	client, err := config.GetClient(meta)
+	if err != nil {
+		return err
+	}
+
+	if checkNetworks {
+		for _, id := range d.Get(AttrNetworkIDs).(*schema.Set).List() {
+			if _, err := client.GetPrivateNetwork(ctx, zone, id.(string)); err != nil {
+				if errors.Is(err, exoapi.ErrNotFound) {
+					return fmt.Errorf(
+						"%s: private network %q not found in zone %s (managed instances can only be attached to private networks of the instance pool zone)",
+						AttrNetworkIDs,
+						id,
+						zone,
+					)
+				}
+				return fmt.Errorf("unable to retrieve private network %q: %w", id, err)
+			}
+		}
+	}
+
+	if checkTemplate {
+		id := d.Get(AttrTemplateID).(string)
+		if _, err := client.GetTemplate(ctx, zone, id); err != nil {
+			if errors.Is(err, exoapi.ErrNotFound) {
+				return fmt.Errorf(
+					"%s: template %q not found in zone %s (templates must be available in the instance pool zone)",
+					AttrTemplateID,
+					id,
+					zone,
+				)
+			}
+			return fmt.Errorf("unable to retrieve template %q: %w", id, err)
+		}
+	}
+
+	return nil
+}
+
 func rCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics { //nolint:gocyclo
 	tflog.Debug(ctx, "beginning create", map[string]interface{}{
 		"id": utils.IDString(d, Name),
@@ -277,6 +474,14 @@ func rCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag
 		pool.TemplateID = &s
 	}
 
+	if v, ok := d.GetOk(AttrTemplateName); ok {
+		s, err := resolveTemplateID(ctx, client, config.GetTemplateCache(meta), zone, v.(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		pool.TemplateID = &s
+	}
+
 	// FIXME: once the "instance_type" attribute has been made required, this check can be removed.
 	if d.Get(AttrServiceOffering).(string) == "" &&
 		d.Get(AttrInstanceType).(string) == "" {
@@ -349,6 +554,12 @@ func rCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag
 		}()
 	}
 
+	if pool.SecurityGroupIDs != nil {
+		if err := checkSecurityGroups(ctx, client, zone, *pool.SecurityGroupIDs); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	enableIPv6 := d.Get(AttrIPv6).(bool)
 	pool.IPv6Enabled = &enableIPv6
 
@@ -364,16 +575,30 @@ func rCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag
 	//  here because there is already a CreateInstancePool() method on the root
 	//  egoscale client clashing with the v2 one. This can be changed once we
 	//  use API V2-only calls.
-	pool, err = client.CreateInstancePool(ctx, zone, pool)
-	if err != nil {
-		return diag.FromErr(err)
+	if sshKeys := utils.SchemaSetToStringArray(d.Get(AttrSSHKeys).(*schema.Set)); len(sshKeys) > 0 {
+		id, err := createInstancePoolWithSSHKeys(ctx, client, zone, pool, sshKeys)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		pool.ID = &id
+	} else {
+		pool, err = client.CreateInstancePool(ctx, zone, pool)
+		if err != nil {
+			return diag.FromErr(err)
+		}
 	}
 	d.SetId(*pool.ID)
 
-	if err := client.WaitInstancePoolConverged(ctx, zone, *pool.ID); err != nil {
+	if err := waitInstancePoolSettled(ctx, client, zone, *pool.ID); err != nil {
 		return diag.FromErr(err)
 	}
 
+	if v := d.Get(AttrInstanceNameTemplate).(string); v != "" {
+		if err := renameInstances(ctx, client, zone, *pool.ID, v); err != nil {
+			return diag.Errorf("error renaming managed instances: %s", err)
+		}
+	}
+
 	tflog.Debug(ctx, "create finished successfully", map[string]interface{}{
 		"id": utils.IDString(d, Name),
 	})
@@ -435,6 +660,10 @@ func rUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag
 		return diag.FromErr(err)
 	}
 
+	if err := checkNotManaged(pool); err != nil {
+		return diag.FromErr(err)
+	}
+
 	var updated bool
 
 	if d.HasChange(AttrAffinityGroupIDs) {
@@ -495,6 +724,10 @@ func rUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag
 		v := d.Get(AttrKeyPair).(string)
 		pool.SSHKey = &v
 		updated = true
+	} else if d.Get(AttrKeyPair).(string) == "" {
+		// Don't send back the (first) SSH key reported by the API,
+		// which would override the SSH keys managed via ssh_keys.
+		pool.SSHKey = nil
 	}
 
 	if d.HasChange(AttrLabels) {
@@ -533,6 +766,9 @@ func rUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag
 			}
 			return &list
 		}()
+		if err := checkSecurityGroups(ctx, client, zone, *pool.SecurityGroupIDs); err != nil {
+			return diag.FromErr(err)
+		}
 		updated = true
 	}
 
@@ -545,7 +781,14 @@ func rUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag
 		updated = true
 	}
 
-	if d.HasChange(AttrTemplateID) {
+	if v := d.Get(AttrTemplateName).(string); v != "" && d.HasChange(AttrTemplateName) {
+		s, err := resolveTemplateID(ctx, client, config.GetTemplateCache(meta), zone, v)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		pool.TemplateID = &s
+		updated = true
+	} else if d.HasChange(AttrTemplateID) {
 		v := d.Get(AttrTemplateID).(string)
 		pool.TemplateID = &v
 		updated = true
@@ -566,21 +809,78 @@ func rUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag
 		}
 	}
 
+	// Applied after the other changes, so that switching from key_pair to ssh_keys
+	// doesn't get overridden; when switching back to key_pair, the SSH key has
+	// already been set above.
+	if d.HasChange(AttrSSHKeys) && d.Get(AttrKeyPair).(string) == "" {
+		sshKeys := utils.SchemaSetToStringArray(d.Get(AttrSSHKeys).(*schema.Set))
+		if err := updateInstancePoolSSHKeys(ctx, client, zone, *pool.ID, sshKeys); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	if d.HasChange(AttrSize) {
-		if err = client.ScaleInstancePool(
-			ctx,
-			zone,
-			pool,
-			int64(d.Get(AttrSize).(int)),
-		); err != nil {
+		size := int64(d.Get(AttrSize).(int))
+		protected := utils.SchemaSetToStringArray(d.Get(AttrScaleInProtection).(*schema.Set))
+
+		if len(protected) > 0 && size < utils.DefaultInt64(pool.Size, 0) {
+			var members []string
+			if pool.InstanceIDs != nil {
+				members = *pool.InstanceIDs
+			}
+
+			evicted, err := scaleInMembers(members, protected, int(*pool.Size-size))
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			tflog.Debug(ctx, "evicting unprotected managed instances", map[string]interface{}{
+				"id":        utils.IDString(d, Name),
+				"instances": evicted,
+			})
+
+			// Evicting members also decreases the pool size accordingly.
+			if err := client.EvictInstancePoolMembers(ctx, zone, pool, evicted); err != nil {
+				return diag.FromErr(err)
+			}
+		} else if err = client.ScaleInstancePool(ctx, zone, pool, size); err != nil {
 			return diag.FromErr(err)
 		}
 	}
 
-	if err := client.WaitInstancePoolConverged(ctx, zone, *pool.ID); err != nil {
+	if err := waitInstancePoolSettled(ctx, client, zone, *pool.ID); err != nil {
 		return diag.FromErr(err)
 	}
 
+	replaceDiskSize := d.HasChange(AttrDiskSize) && d.Get(AttrRollingReplace).(bool)
+	replaceUserData := d.HasChange(AttrUserData) && d.Get(AttrRecreateOnUserDataChange).(bool)
+	if replaceDiskSize || replaceUserData {
+		size := d.Get(AttrSize).(int)
+		minAvailable := size - 1
+		if v, ok := d.GetOk(AttrMinAvailable); ok {
+			minAvailable = v.(int)
+		}
+
+		outdated := func(instance *egoscale.Instance, pool *egoscale.InstancePool) bool {
+			if replaceDiskSize && utils.DefaultInt64(instance.DiskSize, 0) != *pool.DiskSize {
+				return true
+			}
+
+			return replaceUserData && utils.DefaultString(instance.UserData, "") != utils.DefaultString(pool.UserData, "")
+		}
+
+		if err := rRollingReplace(ctx, client, zone, *pool.ID, minAvailable, outdated); err != nil {
+			return diag.Errorf("error replacing managed instances: %s", err)
+		}
+	}
+
+	// Also covers the instances created by scaling or replacement above.
+	if v := d.Get(AttrInstanceNameTemplate).(string); v != "" {
+		if err := renameInstances(ctx, client, zone, *pool.ID, v); err != nil {
+			return diag.Errorf("error renaming managed instances: %s", err)
+		}
+	}
+
 	tflog.Debug(ctx, "update finished successfully", map[string]interface{}{
 		"id": utils.IDString(d, Name),
 	})
@@ -588,6 +888,100 @@ func rUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag
 	return rRead(ctx, d, meta)
 }
 
+// scaleInMembers returns the n pool members to evict when scaling in, among the members
+// not listed in protected (last listed members first). It fails if a protected instance is
+// not a pool member, or if there are not enough unprotected members to evict.
+func scaleInMembers(members, protected []string, n int) ([]string, error) {
+	for _, id := range protected {
+		if !utils.In(members, id) {
+			return nil, fmt.Errorf("%s: instance %q is not a member of the instance pool", AttrScaleInProtection, id)
+		}
+	}
+
+	evicted := make([]string, 0, n)
+	for i := len(members) - 1; i >= 0 && len(evicted) < n; i-- {
+		if !utils.In(protected, members[i]) {
+			evicted = append(evicted, members[i])
+		}
+	}
+
+	if len(evicted) < n {
+		return nil, fmt.Errorf(
+			"unable to remove %d managed instances: only %d of %d members are not listed in %s",
+			n,
+			len(evicted),
+			len(members),
+			AttrScaleInProtection,
+		)
+	}
+
+	return evicted, nil
+}
+
+// rRollingReplace replaces the managed instances of the pool reported as outdated,
+// in batches keeping at least minAvailable instances running: outdated members are
+// evicted, then the pool is scaled back to its original size.
+func rRollingReplace(
+	ctx context.Context,
+	client *egoscale.Client,
+	zone, id string,
+	minAvailable int,
+	outdated func(*egoscale.Instance, *egoscale.InstancePool) bool,
+) error {
+	pool, err := client.GetInstancePool(ctx, zone, id)
+	if err != nil {
+		return err
+	}
+
+	size := int(*pool.Size)
+	batchSize := size - minAvailable
+	if batchSize < 1 {
+		return fmt.Errorf("%s (%d) must be lower than %s (%d)", AttrMinAvailable, minAvailable, AttrSize, size)
+	}
+
+	members := make([]string, 0)
+	if pool.InstanceIDs != nil {
+		for _, instanceID := range *pool.InstanceIDs {
+			instance, err := client.GetInstance(ctx, zone, instanceID)
+			if err != nil {
+				return err
+			}
+
+			if outdated(instance, pool) {
+				members = append(members, instanceID)
+			}
+		}
+	}
+
+	for len(members) > 0 {
+		n := batchSize
+		if n > len(members) {
+			n = len(members)
+		}
+
+		tflog.Debug(ctx, "replacing managed instances", map[string]interface{}{
+			"id":        id,
+			"instances": members[:n],
+		})
+
+		if err := client.EvictInstancePoolMembers(ctx, zone, pool, members[:n]); err != nil {
+			return err
+		}
+
+		if err := client.ScaleInstancePool(ctx, zone, pool, int64(size)); err != nil {
+			return err
+		}
+
+		if err := waitInstancePoolSettled(ctx, client, zone, id); err != nil {
+			return err
+		}
+
+		members = members[n:]
+	}
+
+	return nil
+}
+
 func rDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	tflog.Debug(ctx, "beginning delete", map[string]interface{}{
 		"id": utils.IDString(d, Name),
@@ -604,6 +998,23 @@ func rDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag
 		return diag.FromErr(err)
 	}
 
+	if v := d.Get(AttrManagerType).(string); v != "" {
+		return diag.Errorf(
+			"instance pool %q is managed by %s %s and can't be deleted directly",
+			d.Id(),
+			v,
+			d.Get(AttrManagerID).(string),
+		)
+	}
+
+	if d.Get(AttrDeletionProtection).(bool) {
+		return deletionProtectedDiag(
+			d.Get(AttrName).(string),
+			d.Get(AttrSize).(int),
+			d.Get(AttrInstances).(*schema.Set).Len(),
+		)
+	}
+
 	poolID := d.Id()
 	err = client.DeleteInstancePool(ctx, zone, &egoscale.InstancePool{ID: &poolID})
 	if err != nil {
@@ -658,14 +1069,46 @@ func rApply(ctx context.Context, client *egoscale.Client, d *schema.ResourceData
 		return diag.FromErr(err)
 	}
 
-	if err := d.Set(AttrKeyPair, pool.SSHKey); err != nil {
+	sshKeys, err := getInstancePoolSSHKeys(ctx, client, *pool.ID)
+	if err != nil {
 		return diag.FromErr(err)
 	}
 
+	// The SSH keys are reported either via key_pair (deprecated) or ssh_keys,
+	// depending on which one is used in the configuration. Imported pools
+	// authorizing several SSH keys are reported via ssh_keys.
+	if d.Get(AttrSSHKeys).(*schema.Set).Len() > 0 ||
+		(d.Get(AttrKeyPair).(string) == "" && len(sshKeys) > 1) {
+		if err := d.Set(AttrSSHKeys, sshKeys); err != nil {
+			return diag.FromErr(err)
+		}
+		if err := d.Set(AttrKeyPair, ""); err != nil {
+			return diag.FromErr(err)
+		}
+	} else {
+		if err := d.Set(AttrKeyPair, pool.SSHKey); err != nil {
+			return diag.FromErr(err)
+		}
+		if err := d.Set(AttrSSHKeys, []string{}); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	if err := d.Set(AttrLabels, pool.Labels); err != nil {
 		return diag.FromErr(err)
 	}
 
+	managerID, managerType := "", ""
+	if pool.Manager != nil {
+		managerID, managerType = pool.Manager.ID, pool.Manager.Type
+	}
+	if err := d.Set(AttrManagerID, managerID); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(AttrManagerType, managerType); err != nil {
+		return diag.FromErr(err)
+	}
+
 	if err := d.Set(AttrName, pool.Name); err != nil {
 		return diag.FromErr(err)
 	}
@@ -676,10 +1119,13 @@ func rApply(ctx context.Context, client *egoscale.Client, d *schema.ResourceData
 		}
 	}
 
+	// Security Groups detached out-of-band must show up as drift.
+	securityGroupIDs := []string{}
 	if pool.SecurityGroupIDs != nil {
-		if err := d.Set(AttrSecurityGroupIDs, *pool.SecurityGroupIDs); err != nil {
-			return diag.FromErr(err)
-		}
+		securityGroupIDs = *pool.SecurityGroupIDs
+	}
+	if err := d.Set(AttrSecurityGroupIDs, securityGroupIDs); err != nil {
+		return diag.FromErr(err)
 	}
 
 	instanceType, err := client.GetInstanceType(
@@ -726,6 +1172,7 @@ func rApply(ctx context.Context, client *egoscale.Client, d *schema.ResourceData
 	if pool.InstanceIDs != nil {
 		instanceIDs := make([]string, len(*pool.InstanceIDs))
 		instanceDetails := make([]interface{}, len(*pool.InstanceIDs))
+		instanceNamesByID := make(map[string]string, len(*pool.InstanceIDs))
 
 		for i, id := range *pool.InstanceIDs {
 			instanceIDs[i] = id
@@ -735,6 +1182,7 @@ func rApply(ctx context.Context, client *egoscale.Client, d *schema.ResourceData
 			if err != nil {
 				return diag.FromErr(err)
 			}
+			instanceNamesByID[id] = utils.DefaultString(instance.Name, "")
 
 			instanceType, err := client.GetInstanceType(
 				ctx,
@@ -755,6 +1203,22 @@ func rApply(ctx context.Context, client *egoscale.Client, d *schema.ResourceData
 		if err := d.Set(AttrInstances, instanceDetails); err != nil {
 			return diag.FromErr(err)
 		}
+
+		// Instances not named after the template (e.g. added by an autoscaler or
+		// renamed out-of-band) are renamed by the next apply.
+		notMatching := make([]string, 0)
+		if tmpl := d.Get(AttrInstanceNameTemplate).(string); tmpl != "" {
+			renames, err := instanceNames(instanceIDs, instanceNamesByID, tmpl, zone)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			for id := range renames {
+				notMatching = append(notMatching, id)
+			}
+		}
+		if err := d.Set(AttrInstancesNotMatchingTemplate, notMatching); err != nil {
+			return diag.FromErr(err)
+		}
 	}
 
 	return nil
@@ -769,3 +1233,403 @@ func computeInstanceToResource(instance *egoscale.Instance, instanceType *egosca
 
 	return c
 }
+
+// resolveTemplateID returns the ID of the newest template named name in the specified zone,
+// looking up public templates first then private ones. Resolutions are cached in the
+// provider template cache, to limit API calls when multiple instance pools reference
+// the same template.
+func resolveTemplateID(
+	ctx context.Context,
+	client *egoscale.Client,
+	cache *config.TemplateCache,
+	zone, name string,
+) (string, error) {
+	if id, ok := cache.Get(zone, name); ok {
+		return id, nil
+	}
+
+	for _, visibility := range []string{"public", "private"} {
+		template, err := client.GetTemplateByName(ctx, zone, name, visibility)
+		if err != nil {
+			if errors.Is(err, exoapi.ErrNotFound) {
+				continue
+			}
+			return "", fmt.Errorf("unable to retrieve template %q: %w", name, err)
+		}
+
+		cache.Set(zone, name, *template.ID)
+		return *template.ID, nil
+	}
+
+	return "", fmt.Errorf("template %q not found in zone %s", name, zone)
+}
+
+// rImport imports an Instance Pool, refusing the ones owned by a manager (e.g. an SKS Nodepool).
+func rImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	resources, err := utils.ZonedStateContextFunc(ctx, d, meta)
+	if err != nil {
+		return nil, err
+	}
+
+	zone := d.Get(AttrZone).(string)
+
+	ctx = exoapi.WithEndpoint(ctx, exoapi.NewReqEndpoint(config.GetEnvironment(meta), zone))
+
+	client, err := config.GetClient(meta)
+	if err != nil {
+		return nil, err
+	}
+
+	pool, err := client.GetInstancePool(ctx, zone, d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkNotManaged(pool); err != nil {
+		return nil, err
+	}
+
+	if err := d.Set(AttrDeletionProtection, false); err != nil {
+		return nil, err
+	}
+
+	return resources, nil
+}
+
+// deletionProtectedDiag returns the diagnostic reported when attempting to delete
+// a pool with deletion protection enabled, stating what the deletion would destroy.
+func deletionProtectedDiag(name string, size, members int) diag.Diagnostics {
+	return diag.Diagnostics{{
+		Severity: diag.Error,
+		Summary:  fmt.Sprintf("instance pool %q is protected against deletion", name),
+		Detail: fmt.Sprintf(
+			"Deleting this pool (size %d) would destroy its %d managed instance(s) and their local data. "+
+				"To delete it, first set %q to false and apply the configuration, then destroy the resource.",
+			size,
+			members,
+			AttrDeletionProtection,
+		),
+	}}
+}
+
+// checkNotManaged returns an error if the Instance Pool is owned by a manager (e.g. an SKS Nodepool),
+// as managing it directly would conflict with its manager.
+func checkNotManaged(pool *egoscale.InstancePool) error {
+	if pool.Manager == nil {
+		return nil
+	}
+
+	msg := fmt.Sprintf("instance pool %q is managed by %s %s", *pool.ID, pool.Manager.Type, pool.Manager.ID)
+	if pool.Manager.Type == string(oapi.ManagerTypeSksNodepool) {
+		msg += ": use the exoscale_sks_nodepool resource to manage it instead"
+	}
+
+	return errors.New(msg)
+}
+
+// checkSecurityGroups ensures that the Security Groups to attach to the Instance Pool exist,
+// failing early rather than during the Instance Pool members provisioning.
+func checkSecurityGroups(ctx context.Context, client *egoscale.Client, zone string, ids []string) error {
+	for _, id := range ids {
+		if _, err := client.GetSecurityGroup(ctx, zone, id); err != nil {
+			if errors.Is(err, exoapi.ErrNotFound) {
+				return fmt.Errorf("security group %q not found", id)
+			}
+			return fmt.Errorf("unable to retrieve security group %q: %w", id, err)
+		}
+	}
+
+	return nil
+}
+
+// createInstancePoolWithSSHKeys creates an Instance Pool authorizing several SSH keys,
+// which isn't supported by egoscale's CreateInstancePool(), and returns its ID.
+func createInstancePoolWithSSHKeys(
+	ctx context.Context,
+	client *egoscale.Client,
+	zone string,
+	pool *egoscale.InstancePool,
+	sshKeys []string,
+) (string, error) {
+	body := oapi.CreateInstancePoolJSONRequestBody{
+		Description:    pool.Description,
+		DiskSize:       *pool.DiskSize,
+		InstancePrefix: pool.InstancePrefix,
+		InstanceType:   oapi.InstanceType{Id: pool.InstanceTypeID},
+		Ipv6Enabled:    pool.IPv6Enabled,
+		Name:           *pool.Name,
+		Size:           *pool.Size,
+		SshKeys:        sshKeysToOAPI(sshKeys),
+		Template:       oapi.Template{Id: pool.TemplateID},
+		UserData:       pool.UserData,
+	}
+
+	if pool.AntiAffinityGroupIDs != nil {
+		list := make([]oapi.AntiAffinityGroup, len(*pool.AntiAffinityGroupIDs))
+		for i := range *pool.AntiAffinityGroupIDs {
+			list[i] = oapi.AntiAffinityGroup{Id: &(*pool.AntiAffinityGroupIDs)[i]}
+		}
+		body.AntiAffinityGroups = &list
+	}
+
+	if pool.DeployTargetID != nil {
+		body.DeployTarget = &oapi.DeployTarget{Id: *pool.DeployTargetID}
+	}
+
+	if pool.ElasticIPIDs != nil {
+		list := make([]oapi.ElasticIp, len(*pool.ElasticIPIDs))
+		for i := range *pool.ElasticIPIDs {
+			list[i] = oapi.ElasticIp{Id: &(*pool.ElasticIPIDs)[i]}
+		}
+		body.ElasticIps = &list
+	}
+
+	if pool.Labels != nil {
+		body.Labels = &oapi.Labels{AdditionalProperties: *pool.Labels}
+	}
+
+	if pool.PrivateNetworkIDs != nil {
+		list := make([]oapi.PrivateNetwork, len(*pool.PrivateNetworkIDs))
+		for i := range *pool.PrivateNetworkIDs {
+			list[i] = oapi.PrivateNetwork{Id: &(*pool.PrivateNetworkIDs)[i]}
+		}
+		body.PrivateNetworks = &list
+	}
+
+	if pool.SecurityGroupIDs != nil {
+		list := make([]oapi.SecurityGroup, len(*pool.SecurityGroupIDs))
+		for i := range *pool.SecurityGroupIDs {
+			list[i] = oapi.SecurityGroup{Id: &(*pool.SecurityGroupIDs)[i]}
+		}
+		body.SecurityGroups = &list
+	}
+
+	resp, err := client.CreateInstancePoolWithResponse(ctx, body)
+	if err != nil {
+		return "", err
+	}
+	if resp.JSON200 == nil {
+		return "", fmt.Errorf("unable to create instance pool: unexpected response status %s", resp.Status())
+	}
+
+	op, err := oapi.NewPoller().Poll(ctx, oapi.OperationPoller(client, zone, *resp.JSON200.Id))
+	if err != nil {
+		return "", err
+	}
+
+	ref, ok := op.(*struct {
+		Command *string `json:"command,omitempty"`
+		Id      *string `json:"id,omitempty"` // revive:disable-line
+		Link    *string `json:"link,omitempty"`
+	})
+	if !ok || ref.Id == nil {
+		return "", errors.New("unable to create instance pool: no reference returned by the operation")
+	}
+
+	return *ref.Id, nil
+}
+
+// updateInstancePoolSSHKeys sets the SSH keys authorized in the Instance Pool managed instances.
+func updateInstancePoolSSHKeys(ctx context.Context, client *egoscale.Client, zone, id string, sshKeys []string) error {
+	resp, err := client.UpdateInstancePoolWithResponse(ctx, id, oapi.UpdateInstancePoolJSONRequestBody{
+		SshKeys: sshKeysToOAPI(sshKeys),
+	})
+	if err != nil {
+		return err
+	}
+	if resp.JSON200 == nil {
+		return fmt.Errorf("unable to update instance pool SSH keys: unexpected response status %s", resp.Status())
+	}
+
+	if _, err := oapi.NewPoller().Poll(ctx, oapi.OperationPoller(client, zone, *resp.JSON200.Id)); err != nil {
+		return fmt.Errorf("unable to update instance pool SSH keys: %w", err)
+	}
+
+	return nil
+}
+
+// getInstancePoolSSHKeys returns the names of the SSH keys authorized in the Instance Pool managed instances.
+func getInstancePoolSSHKeys(ctx context.Context, client *egoscale.Client, id string) ([]string, error) {
+	resp, err := client.GetInstancePoolWithResponse(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSON200 == nil {
+		return nil, fmt.Errorf("unable to retrieve instance pool SSH keys: unexpected response status %s", resp.Status())
+	}
+
+	sshKeys := []string{}
+	if resp.JSON200.SshKeys != nil {
+		for _, k := range *resp.JSON200.SshKeys {
+			if k.Name != nil {
+				sshKeys = append(sshKeys, *k.Name)
+			}
+		}
+	} else if resp.JSON200.SshKey != nil && resp.JSON200.SshKey.Name != nil {
+		sshKeys = append(sshKeys, *resp.JSON200.SshKey.Name)
+	}
+
+	return sshKeys, nil
+}
+
+func sshKeysToOAPI(names []string) *[]oapi.SshKey {
+	list := make([]oapi.SshKey, len(names))
+	for i := range names {
+		list[i] = oapi.SshKey{Name: &names[i]}
+	}
+
+	return &list
+}
+
+// instanceNameTemplateToken matches the instance_name_template tokens.
+var instanceNameTemplateToken = regexp.MustCompile(`%.?`)
+
+// hostnameRegexp matches a valid (RFC 1123) hostname.
+var hostnameRegexp = regexp.MustCompile(`^[a-zA-Z0-9]([a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?(\.[a-zA-Z0-9]([a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?)*$`)
+
+// validateInstanceNameTemplate ensures that the instance name template only uses
+// supported tokens, contains the instance index and renders to a valid hostname.
+func validateInstanceNameTemplate(tmpl string) error {
+	for _, token := range instanceNameTemplateToken.FindAllString(tmpl, -1) {
+		if token != "%d" && token != "%z" {
+			return fmt.Errorf("unsupported token %q in instance name template (supported: %%d, %%z)", token)
+		}
+	}
+
+	if !strings.Contains(tmpl, "%d") {
+		return errors.New("instance name template must contain the %d (instance index) token")
+	}
+
+	_, err := renderInstanceName(tmpl, 1, "ch-gva-2")
+	return err
+}
+
+// renderInstanceName renders the instance name template for the instance of the
+// specified index in the zone.
+func renderInstanceName(tmpl string, index int, zone string) (string, error) {
+	name := strings.NewReplacer("%d", strconv.Itoa(index), "%z", zone).Replace(tmpl)
+
+	if len(name) > 253 || !hostnameRegexp.MatchString(name) {
+		return "", fmt.Errorf("instance name template renders to an invalid hostname: %q", name)
+	}
+
+	return name, nil
+}
+
+// instanceNames returns the names to give to the instances whose current names are
+// not rendered from the template: instances already named after the template keep
+// their index, and the others get the lowest free indexes.
+func instanceNames(ids []string, names map[string]string, tmpl, zone string) (map[string]string, error) {
+	indexes := make(map[string]int, len(ids))
+	for i := 1; i <= len(ids); i++ {
+		name, err := renderInstanceName(tmpl, i, zone)
+		if err != nil {
+			return nil, err
+		}
+		indexes[name] = i
+	}
+
+	taken := make(map[int]bool, len(ids))
+	pending := make([]string, 0)
+	for _, id := range ids {
+		if i, ok := indexes[names[id]]; ok && !taken[i] {
+			taken[i] = true
+			continue
+		}
+		pending = append(pending, id)
+	}
+
+	renames := make(map[string]string, len(pending))
+	next := 1
+	for _, id := range pending {
+		for taken[next] {
+			next++
+		}
+		taken[next] = true
+
+		name, err := renderInstanceName(tmpl, next, zone)
+		if err != nil {
+			return nil, err
+		}
+		renames[id] = name
+	}
+
+	return renames, nil
+}
+
+// waitInstancePoolSettled waits until the instance pool has the expected number
+// of members and its state is no longer transient (e.g. scaling-up), so that
+// the state read afterwards is the pool's actual state.
+func waitInstancePoolSettled(ctx context.Context, client *egoscale.Client, zone, id string) error {
+	if err := client.WaitInstancePoolConverged(ctx, zone, id); err != nil {
+		return err
+	}
+
+	_, err := oapi.NewPoller().
+		WithInterval(statePollInterval).
+		Poll(ctx, func(ctx context.Context) (bool, interface{}, error) {
+			pool, err := client.GetInstancePool(ctx, zone, id)
+			if err != nil {
+				return true, nil, err
+			}
+
+			return !instancePoolStateTransient(utils.DefaultString(pool.State, "")), nil, nil
+		})
+	if err != nil {
+		return fmt.Errorf("error waiting for instance pool to settle: %w", err)
+	}
+
+	return nil
+}
+
+// instancePoolStateTransient returns true if the instance pool state is expected
+// to change without any further operation.
+func instancePoolStateTransient(state string) bool {
+	switch oapi.InstancePoolState(state) {
+	case oapi.InstancePoolStateCreating, oapi.InstancePoolStateScalingUp, oapi.InstancePoolStateScalingDown:
+		return true
+	}
+
+	return false
+}
+
+// renameInstances renames the Instance Pool managed instances after the instance name template.
+func renameInstances(ctx context.Context, client *egoscale.Client, zone, id, tmpl string) error {
+	pool, err := client.GetInstancePool(ctx, zone, id)
+	if err != nil {
+		return err
+	}
+	if pool.InstanceIDs == nil {
+		return nil
+	}
+
+	names := make(map[string]string, len(*pool.InstanceIDs))
+	for _, instanceID := range *pool.InstanceIDs {
+		instance, err := client.GetInstance(ctx, zone, instanceID)
+		if err != nil {
+			return err
+		}
+		names[instanceID] = utils.DefaultString(instance.Name, "")
+	}
+
+	renames, err := instanceNames(*pool.InstanceIDs, names, tmpl, zone)
+	if err != nil {
+		return err
+	}
+
+	for instanceID, name := range renames {
+		instanceID, name := instanceID, name
+
+		tflog.Debug(ctx, "renaming managed instance", map[string]interface{}{
+			"id":       instanceID,
+			"old_name": names[instanceID],
+			"new_name": name,
+		})
+
+		if err := client.UpdateInstance(ctx, zone, &egoscale.Instance{ID: &instanceID, Name: &name}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,118 @@
+package instance_pool
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	egoscale "github.com/exoscale/egoscale/v2"
+)
+
+func Test_checkNotManaged(t *testing.T) {
+	id := "c7e0f2e8-0b0a-4b0c-9b1e-3a4f1b1f6a01"
+
+	require.NoError(t, checkNotManaged(&egoscale.InstancePool{ID: &id}))
+
+	err := checkNotManaged(&egoscale.InstancePool{
+		ID: &id,
+		Manager: &egoscale.InstancePoolManager{
+			ID:   "5b7a5c4e-9a3b-4a55-8d4f-4c2b8f0f4f2e",
+			Type: "sks-nodepool",
+		},
+	})
+	require.ErrorContains(t, err, "managed by sks-nodepool 5b7a5c4e-9a3b-4a55-8d4f-4c2b8f0f4f2e")
+	require.ErrorContains(t, err, "exoscale_sks_nodepool")
+}
+
+func Test_validateInstanceNameTemplate(t *testing.T) {
+	require.NoError(t, validateInstanceNameTemplate("web-%d"))
+	require.NoError(t, validateInstanceNameTemplate("web-%z-%d"))
+	require.ErrorContains(t, validateInstanceNameTemplate("web"), "must contain the %d")
+	require.ErrorContains(t, validateInstanceNameTemplate("web-%s-%d"), `unsupported token "%s"`)
+	require.ErrorContains(t, validateInstanceNameTemplate("web-%d%"), `unsupported token "%"`)
+	require.ErrorContains(t, validateInstanceNameTemplate("web_%d"), "invalid hostname")
+	require.ErrorContains(t, validateInstanceNameTemplate("-%d"), "invalid hostname")
+}
+
+func Test_instanceNames(t *testing.T) {
+	ids := []string{"a", "b", "c"}
+
+	renames, err := instanceNames(ids, map[string]string{
+		"a": "pool-1a2b3-xyz",
+		"b": "web-ch-gva-2-2",
+		"c": "pool-4d5e6-abc",
+	}, "web-%z-%d", "ch-gva-2")
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{
+		"a": "web-ch-gva-2-1",
+		"c": "web-ch-gva-2-3",
+	}, renames)
+
+	// Duplicate and out of range indexes are reassigned.
+	renames, err = instanceNames(ids, map[string]string{
+		"a": "web-2",
+		"b": "web-2",
+		"c": "web-7",
+	}, "web-%d", "ch-gva-2")
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{
+		"b": "web-1",
+		"c": "web-3",
+	}, renames)
+
+	renames, err = instanceNames(ids, map[string]string{
+		"a": "web-1",
+		"b": "web-2",
+		"c": "web-3",
+	}, "web-%d", "ch-gva-2")
+	require.NoError(t, err)
+	require.Empty(t, renames)
+}
+
+func Test_instancePoolStateTransient(t *testing.T) {
+	for _, state := range []string{"creating", "scaling-up", "scaling-down"} {
+		require.True(t, instancePoolStateTransient(state), state)
+	}
+
+	for _, state := range []string{"running", "suspended", "destroying", ""} {
+		require.False(t, instancePoolStateTransient(state), state)
+	}
+}
+
+func Test_deletionProtectedDiag(t *testing.T) {
+	diags := deletionProtectedDiag("db", 3, 2)
+
+	require.True(t, diags.HasError())
+	require.Equal(t, `instance pool "db" is protected against deletion`, diags[0].Summary)
+	require.Contains(t, diags[0].Detail, "(size 3) would destroy its 2 managed instance(s)")
+	require.Contains(t, diags[0].Detail, `set "deletion_protection" to false`)
+}
+
+func Test_scaleInMembers(t *testing.T) {
+	members := []string{"a", "b", "c", "d"}
+
+	evicted, err := scaleInMembers(members, []string{"d", "b"}, 2)
+	require.NoError(t, err)
+	require.Equal(t, []string{"c", "a"}, evicted)
+
+	_, err = scaleInMembers(members, []string{"e"}, 1)
+	require.EqualError(t, err, `scale_in_protection: instance "e" is not a member of the instance pool`)
+
+	_, err = scaleInMembers(members, []string{"a", "b", "c"}, 2)
+	require.EqualError(t, err, "unable to remove 2 managed instances: only 1 of 4 members are not listed in scale_in_protection")
+}
+
+type testSizeDiff struct {
+	id       string
+	old, new int
+}
+
+func (d testSizeDiff) Id() string { return d.id }
+
+func (d testSizeDiff) GetChange(string) (interface{}, interface{}) { return d.old, d.new }
+
+func Test_rRequestedInstances(t *testing.T) {
+	require.Equal(t, int64(3), rRequestedInstances(testSizeDiff{new: 3}))
+	require.Equal(t, int64(2), rRequestedInstances(testSizeDiff{id: "x", old: 3, new: 5}))
+	require.Equal(t, int64(-2), rRequestedInstances(testSizeDiff{id: "x", old: 5, new: 3}))
+}
@@ -1,7 +1,11 @@
 package instance_pool_test
 
 import (
+	"context"
 	"fmt"
+	"regexp"
+	"sort"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -11,6 +15,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	egoscale "github.com/exoscale/egoscale/v2"
+	exoapi "github.com/exoscale/egoscale/v2/api"
 
 	"github.com/exoscale/terraform-provider-exoscale/pkg/resources/instance_pool"
 	"github.com/exoscale/terraform-provider-exoscale/pkg/testutils"
@@ -120,6 +125,7 @@ resource "exoscale_instance_pool" "test" {
   instance_type = "%s"
   size = %d
   disk_size = %d
+  rolling_replace = true
   ipv6 = false
   key_pair = exoscale_ssh_keypair.test.name
   affinity_group_ids = [exoscale_affinity.test.id]
@@ -148,6 +154,48 @@ resource "exoscale_instance_pool" "test" {
 	)
 )
 
+// testResourceConfig returns the configuration of an instance pool named name with size
+// managed instances, based on the testutils.TestInstanceTemplateName template unless
+// extra (appended to the instance pool attributes) sets template_name.
+func testResourceConfig(name, extra string, size int) string {
+	template := "  template_id   = data.exoscale_compute_template.ubuntu.id\n"
+	if strings.Contains(extra, "template_name") {
+		template = ""
+	}
+
+	return fmt.Sprintf(`
+locals {
+  zone = "%s"
+}
+
+data "exoscale_compute_template" "ubuntu" {
+  zone = local.zone
+  name = "%s"
+}
+
+resource "exoscale_instance_pool" "test" {
+  zone          = local.zone
+  name          = "%s"
+%s  instance_type = "%s"
+  size          = %d
+  disk_size     = 10
+%s
+
+  timeouts {
+    delete = "10m"
+  }
+}
+`,
+		testutils.TestZoneName,
+		testutils.TestInstanceTemplateName,
+		name,
+		template,
+		rInstanceType,
+		size,
+		extra,
+	)
+}
+
 func testResource(t *testing.T) {
 	var (
 		r            = "exoscale_instance_pool.test"
@@ -198,6 +246,7 @@ func testResource(t *testing.T) {
 						instance_pool.AttrInstancePrefix:          testutils.ValidateString(rInstancePrefix),
 						instance_pool.AttrInstanceType:            testutils.ValidateString(rInstanceType),
 						instance_pool.AttrLabels + ".test":        testutils.ValidateString(rLabelValue),
+						instance_pool.AttrManagerType:             validation.ToDiagFunc(validation.StringIsEmpty),
 						instance_pool.AttrName:                    testutils.ValidateString(rName),
 						instance_pool.AttrSecurityGroupIDs + ".#": testutils.ValidateString("1"),
 						instance_pool.AttrSize:                    testutils.ValidateString(fmt.Sprint(rSize)),
@@ -208,6 +257,9 @@ func testResource(t *testing.T) {
 						instance_pool.AttrInstances + ".#":        testutils.ValidateString(fmt.Sprint(rSize)),
 						instance_pool.AttrZone:                    testutils.ValidateString(testutils.TestZoneName),
 					})),
+					testutils.CheckResourceState(r, testutils.CheckResourceStateValidateAttributes(testutils.TestAttrs{
+						instance_pool.AttrInstances + ".0." + instance_pool.AttrInstanceIPv6Address: validation.ToDiagFunc(validation.IsIPv6Address),
+					})),
 				),
 			},
 			{
@@ -241,6 +293,19 @@ func testResource(t *testing.T) {
 						a.Equal(templateID, *instancePool.TemplateID)
 						a.Equal(expectedUserData, *instancePool.UserData)
 
+						client, err := testutils.APIClient()
+						a.NoError(err)
+
+						ctx := exoapi.WithEndpoint(
+							context.Background(),
+							exoapi.NewReqEndpoint(testutils.TestEnvironment(), testutils.TestZoneName),
+						)
+						for _, id := range *instancePool.InstanceIDs {
+							instance, err := client.GetInstance(ctx, testutils.TestZoneName, id)
+							a.NoError(err)
+							a.Equal(rDiskSizeUpdated, *instance.DiskSize)
+						}
+
 						return nil
 					},
 					testutils.CheckResourceState(r, testutils.CheckResourceStateValidateAttributes(testutils.TestAttrs{
@@ -269,8 +334,9 @@ func testResource(t *testing.T) {
 						return fmt.Sprintf("%s@%s", *instancePool.ID, testutils.TestZoneName), nil
 					}
 				}(&instancePool),
-				ImportState:       true,
-				ImportStateVerify: true,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{instance_pool.AttrRollingReplace, instance_pool.AttrRecreateOnUserDataChange},
 				ImportStateCheck: func(s []*terraform.InstanceState) error {
 					return testutils.CheckResourceAttributes(
 						testutils.TestAttrs{
@@ -302,3 +368,408 @@ func testResource(t *testing.T) {
 		},
 	})
 }
+
+func testResourceIPv6Drift(t *testing.T) {
+	var (
+		r            = "exoscale_instance_pool.test"
+		instancePool egoscale.InstancePool
+		config       = testResourceConfig(acctest.RandomWithPrefix(testutils.Prefix), "  ipv6 = true", 1)
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testutils.AccPreCheck(t) },
+		ProviderFactories: testutils.Providers(),
+		CheckDestroy:      testutils.CheckInstancePoolDestroy(&instancePool),
+		Steps: []resource.TestStep{
+			{
+				// Create
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testutils.CheckInstancePoolExists(r, &instancePool),
+					func(s *terraform.State) error {
+						require.True(t, *instancePool.IPv6Enabled)
+						return nil
+					},
+					testutils.CheckResourceState(r, testutils.CheckResourceStateValidateAttributes(testutils.TestAttrs{
+						instance_pool.AttrIPv6: testutils.ValidateString("true"),
+					})),
+				),
+			},
+			{
+				// Disable IPv6 outside of Terraform and expect the drift to be detected
+				PreConfig: func() {
+					client, err := testutils.APIClient()
+					require.NoError(t, err)
+
+					ctx := exoapi.WithEndpoint(
+						context.Background(),
+						exoapi.NewReqEndpoint(testutils.TestEnvironment(), testutils.TestZoneName),
+					)
+
+					ipv6Enabled := false
+					require.NoError(t, client.UpdateInstancePool(ctx, testutils.TestZoneName, &egoscale.InstancePool{
+						ID:          instancePool.ID,
+						IPv6Enabled: &ipv6Enabled,
+					}))
+				},
+				Config:             config,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testResourceTemplateName(t *testing.T) {
+	var (
+		r            = "exoscale_instance_pool.test"
+		instancePool egoscale.InstancePool
+		config       = testResourceConfig(
+			acctest.RandomWithPrefix(testutils.Prefix),
+			fmt.Sprintf(`  template_name = "%s"`, testutils.TestInstanceTemplateName),
+			1,
+		)
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testutils.AccPreCheck(t) },
+		ProviderFactories: testutils.Providers(),
+		CheckDestroy:      testutils.CheckInstancePoolDestroy(&instancePool),
+		Steps: []resource.TestStep{
+			{
+				// Create
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testutils.CheckInstancePoolExists(r, &instancePool),
+					func(s *terraform.State) error {
+						templateID, err := testutils.AttrFromState(s, "data.exoscale_compute_template.ubuntu", "id")
+						require.NoError(t, err, "unable to retrieve template ID from state")
+
+						require.Equal(t, templateID, *instancePool.TemplateID)
+						return nil
+					},
+					testutils.CheckResourceState(r, testutils.CheckResourceStateValidateAttributes(testutils.TestAttrs{
+						instance_pool.AttrTemplateID:   validation.ToDiagFunc(validation.IsUUID),
+						instance_pool.AttrTemplateName: testutils.ValidateString(testutils.TestInstanceTemplateName),
+					})),
+				),
+			},
+		},
+	})
+}
+
+func testResourceAntiAffinityGroups(t *testing.T) {
+	var (
+		r            = "exoscale_instance_pool.test"
+		instancePool egoscale.InstancePool
+		name         = acctest.RandomWithPrefix(testutils.Prefix)
+		config       = func(affinityGroupIDs string, size int) string {
+			return fmt.Sprintf(`
+resource "exoscale_anti_affinity_group" "a" {
+  name = "%s-a"
+}
+
+resource "exoscale_anti_affinity_group" "b" {
+  name = "%s-b"
+}
+`,
+				name,
+				name,
+			) + testResourceConfig(name, "  affinity_group_ids = "+affinityGroupIDs, size)
+		}
+		// checkMembersAntiAffinityGroups checks the number of Anti-Affinity Groups
+		// each Instance Pool member is placed in, in creation order.
+		checkMembersAntiAffinityGroups = func(expected ...int) resource.TestCheckFunc {
+			return func(s *terraform.State) error {
+				client, err := testutils.APIClient()
+				if err != nil {
+					return err
+				}
+
+				ctx := exoapi.WithEndpoint(
+					context.Background(),
+					exoapi.NewReqEndpoint(testutils.TestEnvironment(), testutils.TestZoneName),
+				)
+
+				counts := make([]int, 0, len(*instancePool.InstanceIDs))
+				instances := make([]*egoscale.Instance, 0, len(*instancePool.InstanceIDs))
+				for _, id := range *instancePool.InstanceIDs {
+					instance, err := client.GetInstance(ctx, testutils.TestZoneName, id)
+					if err != nil {
+						return err
+					}
+					instances = append(instances, instance)
+				}
+				sort.Slice(instances, func(i, j int) bool {
+					return instances[i].CreatedAt.Before(*instances[j].CreatedAt)
+				})
+				for _, instance := range instances {
+					n := 0
+					if instance.AntiAffinityGroupIDs != nil {
+						n = len(*instance.AntiAffinityGroupIDs)
+					}
+					counts = append(counts, n)
+				}
+
+				require.Equal(t, expected, counts)
+				return nil
+			}
+		}
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testutils.AccPreCheck(t) },
+		ProviderFactories: testutils.Providers(),
+		CheckDestroy:      testutils.CheckInstancePoolDestroy(&instancePool),
+		Steps: []resource.TestStep{
+			{
+				// Create
+				Config: config("[exoscale_anti_affinity_group.a.id]", 1),
+				Check: resource.ComposeTestCheckFunc(
+					testutils.CheckInstancePoolExists(r, &instancePool),
+					checkMembersAntiAffinityGroups(1),
+					testutils.CheckResourceState(r, testutils.CheckResourceStateValidateAttributes(testutils.TestAttrs{
+						instance_pool.AttrAffinityGroupIDs + ".#": testutils.ValidateString("1"),
+					})),
+				),
+			},
+			{
+				// Update: the second Anti-Affinity Group only applies to members created afterwards
+				Config: config("[exoscale_anti_affinity_group.a.id, exoscale_anti_affinity_group.b.id]", 2),
+				Check: resource.ComposeTestCheckFunc(
+					testutils.CheckInstancePoolExists(r, &instancePool),
+					func(s *terraform.State) error {
+						require.Len(t, *instancePool.AntiAffinityGroupIDs, 2)
+						return nil
+					},
+					checkMembersAntiAffinityGroups(1, 2),
+					testutils.CheckResourceState(r, testutils.CheckResourceStateValidateAttributes(testutils.TestAttrs{
+						instance_pool.AttrAffinityGroupIDs + ".#": testutils.ValidateString("2"),
+					})),
+				),
+			},
+		},
+	})
+}
+
+func testResourceRecreateOnUserDataChange(t *testing.T) {
+	var (
+		r            = "exoscale_instance_pool.test"
+		instancePool egoscale.InstancePool
+		memberIDs    []string
+		name         = acctest.RandomWithPrefix(testutils.Prefix)
+		config       = func(userData string) string {
+			return testResourceConfig(name, fmt.Sprintf(`
+  user_data                    = "%s"
+  recreate_on_user_data_change = true
+  min_available                = 1`,
+				userData,
+			), 2)
+		}
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testutils.AccPreCheck(t) },
+		ProviderFactories: testutils.Providers(),
+		CheckDestroy:      testutils.CheckInstancePoolDestroy(&instancePool),
+		Steps: []resource.TestStep{
+			{
+				// Create
+				Config: config(rUserData),
+				Check: resource.ComposeTestCheckFunc(
+					testutils.CheckInstancePoolExists(r, &instancePool),
+					func(s *terraform.State) error {
+						memberIDs = append([]string{}, *instancePool.InstanceIDs...)
+						return nil
+					},
+				),
+			},
+			{
+				// Update: all members are replaced to run the new user data
+				Config: config(rUserDataUpdated),
+				Check: resource.ComposeTestCheckFunc(
+					testutils.CheckInstancePoolExists(r, &instancePool),
+					func(s *terraform.State) error {
+						a := require.New(t)
+
+						a.Len(*instancePool.InstanceIDs, 2)
+						for _, id := range *instancePool.InstanceIDs {
+							a.NotContains(memberIDs, id)
+						}
+
+						expectedUserData, _, err := utils.EncodeUserData(rUserDataUpdated)
+						a.NoError(err)
+						a.Equal(expectedUserData, *instancePool.UserData)
+
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func testResourceSecurityGroupsNotFound(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testutils.AccPreCheck(t) },
+		ProviderFactories: testutils.Providers(),
+		Steps: []resource.TestStep{
+			{
+				Config: testResourceConfig(
+					acctest.RandomWithPrefix(testutils.Prefix),
+					`  security_group_ids = ["00000000-0000-0000-0000-000000000000"]`,
+					1,
+				),
+				ExpectError: regexp.MustCompile(`security group "00000000-0000-0000-0000-000000000000" not found`),
+			},
+		},
+	})
+}
+
+func testResourceExternallyManagedSize(t *testing.T) {
+	var (
+		r            = "exoscale_instance_pool.test"
+		instancePool egoscale.InstancePool
+		config       = testResourceConfig(
+			acctest.RandomWithPrefix(testutils.Prefix),
+			"  externally_managed_size = true",
+			1,
+		)
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testutils.AccPreCheck(t) },
+		ProviderFactories: testutils.Providers(),
+		CheckDestroy:      testutils.CheckInstancePoolDestroy(&instancePool),
+		Steps: []resource.TestStep{
+			{
+				// Create
+				Config: config,
+				Check:  testutils.CheckInstancePoolExists(r, &instancePool),
+			},
+			{
+				// Scale the pool out-of-band, as an external autoscaler would: no change must be planned.
+				PreConfig: func() {
+					client, err := testutils.APIClient()
+					require.NoError(t, err)
+
+					ctx := exoapi.WithEndpoint(
+						context.Background(),
+						exoapi.NewReqEndpoint(testutils.TestEnvironment(), testutils.TestZoneName),
+					)
+					require.NoError(t, client.ScaleInstancePool(ctx, testutils.TestZoneName, &instancePool, 2))
+				},
+				Config:   config,
+				PlanOnly: true,
+			},
+			{
+				// The size managed externally is reported as is.
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testutils.CheckInstancePoolExists(r, &instancePool),
+					testutils.CheckResourceState(r, testutils.CheckResourceStateValidateAttributes(testutils.TestAttrs{
+						instance_pool.AttrExternallyManagedSize: testutils.ValidateString("true"),
+						instance_pool.AttrSize:                  testutils.ValidateString("2"),
+					})),
+				),
+			},
+		},
+	})
+}
+
+func testResourceSSHKeys(t *testing.T) {
+	var (
+		r            = "exoscale_instance_pool.test"
+		instancePool egoscale.InstancePool
+		name         = acctest.RandomWithPrefix(testutils.Prefix)
+		config       = func(sshKeys string) string {
+			return fmt.Sprintf(`
+resource "exoscale_ssh_keypair" "a" {
+  name = "%s-a"
+}
+
+resource "exoscale_ssh_keypair" "b" {
+  name = "%s-b"
+}
+`,
+				name,
+				name,
+			) + testResourceConfig(name, "  ssh_keys = "+sshKeys, 1)
+		}
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testutils.AccPreCheck(t) },
+		ProviderFactories: testutils.Providers(),
+		CheckDestroy:      testutils.CheckInstancePoolDestroy(&instancePool),
+		Steps: []resource.TestStep{
+			{
+				// Create
+				Config: config("[exoscale_ssh_keypair.a.name, exoscale_ssh_keypair.b.name]"),
+				Check: resource.ComposeTestCheckFunc(
+					testutils.CheckInstancePoolExists(r, &instancePool),
+					testutils.CheckResourceState(r, testutils.CheckResourceStateValidateAttributes(testutils.TestAttrs{
+						instance_pool.AttrKeyPair:        validation.ToDiagFunc(validation.StringIsEmpty),
+						instance_pool.AttrSSHKeys + ".#": testutils.ValidateString("2"),
+					})),
+				),
+			},
+			{
+				// Update
+				Config: config("[exoscale_ssh_keypair.b.name]"),
+				Check: resource.ComposeTestCheckFunc(
+					testutils.CheckInstancePoolExists(r, &instancePool),
+					resource.TestCheckTypeSetElemAttr(r, instance_pool.AttrSSHKeys+".*", name+"-b"),
+					testutils.CheckResourceState(r, testutils.CheckResourceStateValidateAttributes(testutils.TestAttrs{
+						instance_pool.AttrSSHKeys + ".#": testutils.ValidateString("1"),
+					})),
+				),
+			},
+		},
+	})
+}
+
+func testResourceDeletionProtection(t *testing.T) {
+	var (
+		r            = "exoscale_instance_pool.test"
+		instancePool egoscale.InstancePool
+		poolName     = acctest.RandomWithPrefix(testutils.Prefix)
+		config       = func(protected bool) string {
+			return testResourceConfig(poolName, fmt.Sprintf("  deletion_protection = %t", protected), 1)
+		}
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testutils.AccPreCheck(t) },
+		ProviderFactories: testutils.Providers(),
+		CheckDestroy:      testutils.CheckInstancePoolDestroy(&instancePool),
+		Steps: []resource.TestStep{
+			{
+				// Create
+				Config: config(true),
+				Check: resource.ComposeTestCheckFunc(
+					testutils.CheckInstancePoolExists(r, &instancePool),
+					testutils.CheckResourceState(r, testutils.CheckResourceStateValidateAttributes(testutils.TestAttrs{
+						instance_pool.AttrDeletionProtection: testutils.ValidateString("true"),
+					})),
+				),
+			},
+			{
+				// Destroying the protected pool must fail
+				Config:      config(true),
+				Destroy:     true,
+				ExpectError: regexp.MustCompile(`is protected against deletion`),
+			},
+			{
+				// Disable the protection, allowing the pool to be destroyed
+				Config: config(false),
+				Check: resource.ComposeTestCheckFunc(
+					testutils.CheckInstancePoolExists(r, &instancePool),
+					testutils.CheckResourceState(r, testutils.CheckResourceStateValidateAttributes(testutils.TestAttrs{
+						instance_pool.AttrDeletionProtection: testutils.ValidateString("false"),
+					})),
+				),
+			},
+		},
+	})
+}
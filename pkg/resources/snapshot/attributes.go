@@ -0,0 +1,13 @@
+package snapshot
+
+const (
+	Name = "exoscale_snapshot"
+
+	AttrCreatedAt  = "created_at"
+	AttrID         = "id"
+	AttrInstanceID = "instance_id"
+	AttrName       = "name"
+	AttrSize       = "size"
+	AttrState      = "state"
+	AttrZone       = "zone"
+)
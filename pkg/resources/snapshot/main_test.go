@@ -0,0 +1,7 @@
+package snapshot_test
+
+import "testing"
+
+func TestSnapshot(t *testing.T) {
+	t.Run("Resource", testResource)
+}
@@ -0,0 +1,223 @@
+package snapshot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	egoscale "github.com/exoscale/egoscale/v2"
+	exoapi "github.com/exoscale/egoscale/v2/api"
+	"github.com/exoscale/egoscale/v2/oapi"
+
+	"github.com/exoscale/terraform-provider-exoscale/pkg/config"
+	"github.com/exoscale/terraform-provider-exoscale/pkg/utils"
+)
+
+func Resource() *schema.Resource {
+	return &schema.Resource{
+		Description: `Manage Exoscale [Compute Instance Snapshots](https://community.exoscale.com/documentation/compute/snapshots/).
+
+A snapshot captures the storage volume of a compute instance at creation time; its name is assigned by the platform.`,
+		Schema: map[string]*schema.Schema{
+			AttrCreatedAt: {
+				Description: "The snapshot creation date.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			AttrInstanceID: {
+				Description: "The [exoscale_compute_instance](./compute_instance.md) (ID) to snapshot the storage volume of.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			AttrName: {
+				Description: "The snapshot name.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			AttrSize: {
+				Description: "The snapshot size (GiB).",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			AttrState: {
+				Description: "The snapshot state.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			AttrZone: {
+				Description:      "The Exoscale [Zone](https://www.exoscale.com/datacenters/) name.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: utils.ValidateZone(),
+			},
+		},
+
+		CreateContext: rCreate,
+		ReadContext:   rRead,
+		DeleteContext: rDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: utils.ZonedStateContextFunc,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(config.DefaultTimeout),
+			Read:   schema.DefaultTimeout(config.DefaultTimeout),
+			Delete: schema.DefaultTimeout(config.DefaultTimeout),
+		},
+	}
+}
+
+func rCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tflog.Debug(ctx, "beginning create", map[string]interface{}{
+		"id": utils.IDString(d, Name),
+	})
+
+	zone := d.Get(AttrZone).(string)
+
+	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutCreate))
+	ctx = exoapi.WithEndpoint(ctx, exoapi.NewReqEndpoint(config.GetEnvironment(meta), zone))
+	defer cancel()
+
+	client, err := config.GetClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	instanceID := d.Get(AttrInstanceID).(string)
+	snapshot, err := client.CreateInstanceSnapshot(ctx, zone, &egoscale.Instance{ID: &instanceID})
+	if err != nil {
+		return diag.Errorf("unable to create snapshot: %s", err)
+	}
+
+	d.SetId(*snapshot.ID)
+
+	if err := waitSnapshotReady(ctx, client, zone, *snapshot.ID); err != nil {
+		return diag.Errorf("error waiting for snapshot to be ready: %s", err)
+	}
+
+	tflog.Debug(ctx, "create finished successfully", map[string]interface{}{
+		"id": utils.IDString(d, Name),
+	})
+
+	return rRead(ctx, d, meta)
+}
+
+func rRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tflog.Debug(ctx, "beginning read", map[string]interface{}{
+		"id": utils.IDString(d, Name),
+	})
+
+	zone := d.Get(AttrZone).(string)
+
+	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutRead))
+	ctx = exoapi.WithEndpoint(ctx, exoapi.NewReqEndpoint(config.GetEnvironment(meta), zone))
+	defer cancel()
+
+	client, err := config.GetClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	snapshot, err := client.GetSnapshot(ctx, zone, d.Id())
+	if err != nil {
+		if errors.Is(err, exoapi.ErrNotFound) {
+			// Resource doesn't exist anymore, signaling the core to remove it from the state.
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	tflog.Debug(ctx, "read finished successfully", map[string]interface{}{
+		"id": utils.IDString(d, Name),
+	})
+
+	return diag.FromErr(rApply(ctx, d, snapshot))
+}
+
+func rDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tflog.Debug(ctx, "beginning delete", map[string]interface{}{
+		"id": utils.IDString(d, Name),
+	})
+
+	zone := d.Get(AttrZone).(string)
+
+	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutDelete))
+	ctx = exoapi.WithEndpoint(ctx, exoapi.NewReqEndpoint(config.GetEnvironment(meta), zone))
+	defer cancel()
+
+	client, err := config.GetClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id := d.Id()
+	if err := client.DeleteSnapshot(ctx, zone, &egoscale.Snapshot{ID: &id}); err != nil {
+		return diag.FromErr(err)
+	}
+
+	tflog.Debug(ctx, "delete finished successfully", map[string]interface{}{
+		"id": utils.IDString(d, Name),
+	})
+
+	return nil
+}
+
+func rApply(
+	_ context.Context,
+	d *schema.ResourceData,
+	snapshot *egoscale.Snapshot,
+) error {
+	if snapshot.CreatedAt != nil {
+		if err := d.Set(AttrCreatedAt, snapshot.CreatedAt.String()); err != nil {
+			return err
+		}
+	}
+
+	if err := d.Set(AttrInstanceID, utils.DefaultString(snapshot.InstanceID, "")); err != nil {
+		return err
+	}
+
+	if err := d.Set(AttrName, utils.DefaultString(snapshot.Name, "")); err != nil {
+		return err
+	}
+
+	if err := d.Set(AttrSize, utils.DefaultInt64(snapshot.Size, 0)); err != nil {
+		return err
+	}
+
+	if err := d.Set(AttrState, utils.DefaultString(snapshot.State, "")); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// waitSnapshotReady waits until the snapshot reaches the "ready" state, failing if it ends up in "error" state.
+func waitSnapshotReady(ctx context.Context, client *egoscale.Client, zone, id string) error {
+	_, err := oapi.NewPoller().
+		Poll(ctx, func(ctx context.Context) (bool, interface{}, error) {
+			snapshot, err := client.GetSnapshot(ctx, zone, id)
+			if err != nil {
+				return false, nil, err
+			}
+
+			switch state := utils.DefaultString(snapshot.State, ""); state {
+			case string(oapi.SnapshotStateReady):
+				return true, snapshot, nil
+			case string(oapi.SnapshotStateError):
+				return false, nil, fmt.Errorf("snapshot in %q state", state)
+			default:
+				return false, nil, nil
+			}
+		})
+
+	return err
+}
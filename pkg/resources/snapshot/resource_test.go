@@ -0,0 +1,114 @@
+package snapshot_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/assert"
+
+	egoscale "github.com/exoscale/egoscale/v2"
+
+	"github.com/exoscale/terraform-provider-exoscale/pkg/resources/snapshot"
+	"github.com/exoscale/terraform-provider-exoscale/pkg/testutils"
+)
+
+var (
+	rInstanceName = acctest.RandomWithPrefix(testutils.Prefix)
+
+	rConfigCreate = fmt.Sprintf(`
+locals {
+  zone = "%s"
+}
+
+data "exoscale_compute_template" "ubuntu" {
+  zone = local.zone
+  name = "%s"
+}
+
+resource "exoscale_compute_instance" "test" {
+  zone        = local.zone
+  name        = "%s"
+  type        = "standard.tiny"
+  template_id = data.exoscale_compute_template.ubuntu.id
+  disk_size   = 10
+  state       = "stopped"
+}
+
+resource "exoscale_snapshot" "test" {
+  zone        = local.zone
+  instance_id = exoscale_compute_instance.test.id
+
+  timeouts {
+    create = "10m"
+  }
+}
+`,
+		testutils.TestZoneName,
+		testutils.TestInstanceTemplateName,
+		rInstanceName,
+	)
+)
+
+func testResource(t *testing.T) {
+	var (
+		r   = snapshot.Name + ".test"
+		res egoscale.Snapshot
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testutils.AccPreCheck(t) },
+		ProviderFactories: testutils.Providers(),
+		CheckDestroy:      testutils.CheckSnapshotDestroy(&res),
+		Steps: []resource.TestStep{
+			{
+				// Create
+				Config: rConfigCreate,
+				Check: resource.ComposeTestCheckFunc(
+					testutils.CheckSnapshotExists(r, &res),
+					func(s *terraform.State) error {
+						a := assert.New(t)
+
+						instanceID, err := testutils.AttrFromState(s, "exoscale_compute_instance.test", "id")
+						a.NoError(err, "unable to retrieve instance ID from state")
+
+						a.Equal(instanceID, *res.InstanceID)
+						a.Equal("ready", *res.State)
+
+						return nil
+					},
+					testutils.CheckResourceState(r, testutils.CheckResourceStateValidateAttributes(testutils.TestAttrs{
+						snapshot.AttrCreatedAt:  validation.ToDiagFunc(validation.NoZeroValues),
+						snapshot.AttrInstanceID: validation.ToDiagFunc(validation.IsUUID),
+						snapshot.AttrName:       validation.ToDiagFunc(validation.NoZeroValues),
+						snapshot.AttrSize:       testutils.ValidateString("10"),
+						snapshot.AttrState:      testutils.ValidateString("ready"),
+						snapshot.AttrZone:       testutils.ValidateString(testutils.TestZoneName),
+					})),
+				),
+			},
+			{
+				// Import
+				ResourceName: r,
+				ImportStateIdFunc: func(snapshot *egoscale.Snapshot) resource.ImportStateIdFunc {
+					return func(*terraform.State) (string, error) {
+						return fmt.Sprintf("%s@%s", *snapshot.ID, testutils.TestZoneName), nil
+					}
+				}(&res),
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateCheck: func(s []*terraform.InstanceState) error {
+					return testutils.CheckResourceAttributes(
+						testutils.TestAttrs{
+							snapshot.AttrInstanceID: validation.ToDiagFunc(validation.IsUUID),
+							snapshot.AttrState:      testutils.ValidateString("ready"),
+						},
+						s[0].Attributes)
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,215 @@
+package zones
+
+import (
+	"context"
+	"fmt"
+
+	exoscale "github.com/exoscale/egoscale/v2"
+	exoapi "github.com/exoscale/egoscale/v2/api"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/exoscale/terraform-provider-exoscale/pkg/config"
+	providerConfig "github.com/exoscale/terraform-provider-exoscale/pkg/provider/config"
+	"github.com/exoscale/terraform-provider-exoscale/pkg/utils"
+)
+
+const DataSourceZoneDescription = `Fetch the services available in an Exoscale [Zone](https://www.exoscale.com/datacenters/) and its endpoints,
+allowing to enable features of a configuration depending on their availability.
+
+Corresponding data source: [exoscale_zones](./zones.md).`
+
+var _ datasource.DataSourceWithConfigure = &DataSourceZone{}
+
+func NewDataSourceZone() datasource.DataSource {
+	return &DataSourceZone{}
+}
+
+type DataSourceZone struct {
+	client *exoscale.Client
+	env    string
+}
+
+type DataSourceZoneModel struct {
+	Id                    types.String `tfsdk:"id"`
+	APIEndpoint           types.String `tfsdk:"api_endpoint"`
+	BlockStorageAvailable types.Bool   `tfsdk:"block_storage_available"`
+	DBaaSAvailable        types.Bool   `tfsdk:"dbaas_available"`
+	Name                  types.String `tfsdk:"name"`
+	NLBAvailable          types.Bool   `tfsdk:"nlb_available"`
+	SKSAvailable          types.Bool   `tfsdk:"sks_available"`
+	SOSAvailable          types.Bool   `tfsdk:"sos_available"`
+	SOSEndpoint           types.String `tfsdk:"sos_endpoint"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+// zoneServices represents the services available in a zone.
+type zoneServices struct {
+	BlockStorage bool
+	DBaaS        bool
+	NLB          bool
+	SKS          bool
+	SOS          bool
+}
+
+// knownZoneServices maps the zones to their available services. The API doesn't
+// expose the zone capabilities: the services of zones unknown to this map are
+// reported as null (with a warning) rather than as unavailable, so that
+// configurations depending on them fail instead of silently skipping features.
+var knownZoneServices = map[string]zoneServices{
+	"at-vie-1": {BlockStorage: true, DBaaS: true, NLB: true, SKS: true, SOS: true},
+	"bg-sof-1": {BlockStorage: true, DBaaS: true, NLB: true, SKS: true, SOS: true},
+	"ch-dk-2":  {BlockStorage: true, DBaaS: true, NLB: true, SKS: true, SOS: true},
+	"ch-gva-2": {BlockStorage: true, DBaaS: true, NLB: true, SKS: true, SOS: true},
+	"de-fra-1": {BlockStorage: true, DBaaS: true, NLB: true, SKS: true, SOS: true},
+	"de-muc-1": {BlockStorage: true, DBaaS: true, NLB: true, SKS: true, SOS: true},
+}
+
+func (d *DataSourceZone) Metadata(
+	ctx context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_zone"
+}
+
+func (d *DataSourceZone) Schema(
+	ctx context.Context,
+	req datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: DataSourceZoneDescription,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of this resource.",
+				Computed:            true,
+			},
+			"api_endpoint": schema.StringAttribute{
+				MarkdownDescription: "The zone API endpoint URL.",
+				Computed:            true,
+			},
+			"block_storage_available": schema.BoolAttribute{
+				MarkdownDescription: "Whether Block Storage is available in the zone (null if unknown to the provider).",
+				Computed:            true,
+			},
+			"dbaas_available": schema.BoolAttribute{
+				MarkdownDescription: "Whether [Database Services](../resources/database.md) are available in the zone (null if unknown to the provider).",
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The Exoscale Zone name.",
+				Required:            true,
+			},
+			"nlb_available": schema.BoolAttribute{
+				MarkdownDescription: "Whether [Network Load Balancers](../resources/nlb.md) are available in the zone (null if unknown to the provider).",
+				Computed:            true,
+			},
+			"sks_available": schema.BoolAttribute{
+				MarkdownDescription: "Whether [SKS clusters](../resources/sks_cluster.md) are available in the zone (null if unknown to the provider).",
+				Computed:            true,
+			},
+			"sos_available": schema.BoolAttribute{
+				MarkdownDescription: "Whether the Simple Object Storage (SOS) is available in the zone (null if unknown to the provider).",
+				Computed:            true,
+			},
+			"sos_endpoint": schema.StringAttribute{
+				MarkdownDescription: "The zone Simple Object Storage (SOS) endpoint URL (null unless SOS is known to be available in the zone, or outside of the production API environment).",
+				Computed:            true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Read: true,
+			}),
+		},
+	}
+}
+
+func (d *DataSourceZone) Configure(
+	ctx context.Context,
+	req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*providerConfig.ExoscaleProviderConfig).ClientV2
+	d.env = req.ProviderData.(*providerConfig.ExoscaleProviderConfig).Environment
+}
+
+func (d *DataSourceZone) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data DataSourceZoneModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set timeout
+	t, diags := data.Timeouts.Read(ctx, config.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, t)
+	defer cancel()
+
+	name := data.Name.ValueString()
+
+	zones, err := d.client.ListZones(exoapi.WithEndpoint(ctx, exoapi.NewReqEndpoint(d.env, config.DefaultZone)))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list zones: %s", err))
+		return
+	}
+	if !utils.In(zones, name) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Zone %q not found", name))
+		return
+	}
+
+	endpoint := exoapi.NewReqEndpoint(d.env, name)
+
+	data.Id = types.StringValue(name)
+	data.APIEndpoint = types.StringValue(fmt.Sprintf("https://%s/v2", endpoint.Host()))
+	data.SOSEndpoint = types.StringNull()
+	data.BlockStorageAvailable = types.BoolNull()
+	data.DBaaSAvailable = types.BoolNull()
+	data.NLBAvailable = types.BoolNull()
+	data.SKSAvailable = types.BoolNull()
+	data.SOSAvailable = types.BoolNull()
+
+	if services, ok := knownZoneServices[name]; ok {
+		data.BlockStorageAvailable = types.BoolValue(services.BlockStorage)
+		data.DBaaSAvailable = types.BoolValue(services.DBaaS)
+		data.NLBAvailable = types.BoolValue(services.NLB)
+		data.SKSAvailable = types.BoolValue(services.SKS)
+		data.SOSAvailable = types.BoolValue(services.SOS)
+	} else {
+		resp.Diagnostics.AddWarning(
+			"Unknown zone services",
+			fmt.Sprintf("The services available in zone %q are not known to this provider version: "+
+				"the *_available attributes are null.", name),
+		)
+	}
+
+	if v := sosEndpoint(d.env, name); v != "" && data.SOSAvailable.ValueBool() {
+		data.SOSEndpoint = types.StringValue(v)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// sosEndpoint returns the SOS endpoint URL of the zone, which is only known for
+// the production API environment.
+func sosEndpoint(env, zone string) string {
+	if env != config.DefaultEnvironment {
+		return ""
+	}
+
+	return fmt.Sprintf("https://sos-%s.exo.io", zone)
+}
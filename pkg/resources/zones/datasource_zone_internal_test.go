@@ -0,0 +1,21 @@
+package zones
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/exoscale/terraform-provider-exoscale/pkg/config"
+)
+
+func Test_knownZoneServices(t *testing.T) {
+	for _, zone := range config.Zones {
+		_, ok := knownZoneServices[zone]
+		require.True(t, ok, "missing services of zone %q", zone)
+	}
+}
+
+func Test_sosEndpoint(t *testing.T) {
+	require.Equal(t, "https://sos-ch-gva-2.exo.io", sosEndpoint(config.DefaultEnvironment, "ch-gva-2"))
+	require.Empty(t, sosEndpoint("ppapi", "ch-gva-2"))
+}
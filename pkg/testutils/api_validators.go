@@ -379,3 +379,51 @@ func CheckInstancePoolDestroy(pool *egoscale.InstancePool) resource.TestCheckFun
 		)
 	}
 }
+
+func CheckSnapshotExists(r string, snapshot *egoscale.Snapshot) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[r]
+		if !ok {
+			return errors.New("resource not found in the state")
+		}
+
+		if rs.Primary.ID == "" {
+			return errors.New("resource ID not set")
+		}
+
+		client, err := APIClient()
+		if err != nil {
+			return err
+		}
+		ctx := exoapi.WithEndpoint(context.Background(), exoapi.NewReqEndpoint(TestEnvironment(), TestZoneName))
+
+		res, err := client.GetSnapshot(ctx, TestZoneName, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		*snapshot = *res
+		return nil
+	}
+}
+
+func CheckSnapshotDestroy(snapshot *egoscale.Snapshot) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client, err := APIClient()
+		if err != nil {
+			return err
+		}
+		ctx := exoapi.WithEndpoint(context.Background(), exoapi.NewReqEndpoint(TestEnvironment(), TestZoneName))
+
+		_, err = client.GetSnapshot(ctx, TestZoneName, *snapshot.ID)
+		if err != nil {
+			if errors.Is(err, exoapi.ErrNotFound) {
+				return nil
+			}
+
+			return err
+		}
+
+		return errors.New("Snapshot still exists")
+	}
+}
@@ -9,14 +9,19 @@ import (
 	"fmt"
 	"io"
 	"net"
+	"regexp"
+	"sort"
 	"strings"
+	"sync"
 
 	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	egoscale "github.com/exoscale/egoscale/v2"
+	exoapi "github.com/exoscale/egoscale/v2/api"
+	"github.com/exoscale/egoscale/v2/oapi"
 
 	"github.com/exoscale/terraform-provider-exoscale/pkg/config"
 )
@@ -187,6 +192,31 @@ func DecodeUserData(data string) (string, error) {
 	return string(userData), nil
 }
 
+// NormalizeUserData returns the canonical (decoded and decompressed) form of user data
+// supplied either plain, base64 encoded or gzipped (and base64 encoded).
+func NormalizeUserData(userData string) string {
+	if len(userData) > 2 && userData[0] == '\x1f' && userData[1] == '\x8b' {
+		userData = base64.StdEncoding.EncodeToString([]byte(userData))
+	}
+
+	if _, err := base64.StdEncoding.DecodeString(userData); err != nil {
+		return userData
+	}
+
+	decoded, err := DecodeUserData(userData)
+	if err != nil {
+		return userData
+	}
+
+	return decoded
+}
+
+// SuppressUserDataDiff https://www.terraform.io/plugin/sdkv2/schemas/schema-behaviors#diffsuppressfunc
+// Do not show differences between semantically equal user data (e.g. plain and base64 encoded)
+func SuppressUserDataDiff(k, old, new string, d *schema.ResourceData) bool {
+	return NormalizeUserData(old) == NormalizeUserData(new)
+}
+
 // ParseIAMAccessKeyResource parses IAM key format
 func ParseIAMAccessKeyResource(v string) (*egoscale.IAMAccessKeyResource, error) {
 	var iamAccessKeyResource egoscale.IAMAccessKeyResource
@@ -213,9 +243,88 @@ func ParseIAMAccessKeyResource(v string) (*egoscale.IAMAccessKeyResource, error)
 	return &iamAccessKeyResource, nil
 }
 
-// ValidateZone validates zone string.
+// ValidateZone validates that the given field contains a known Exoscale zone name.
+// In case of a typo, the closest known zone is suggested in the error message.
+// Validation happens at plan time without access to the API client, so the
+// zones list is the one known by the provider (config.Zones).
 func ValidateZone() schema.SchemaValidateDiagFunc {
-	return validation.ToDiagFunc(validation.StringInSlice(config.Zones, false))
+	return func(v interface{}, _ cty.Path) diag.Diagnostics {
+		value, ok := v.(string)
+		if !ok {
+			return diag.Errorf("expected zone type to be string, got %T", v)
+		}
+
+		if In(config.Zones, value) {
+			return nil
+		}
+
+		// Resources are zonal: multi-zone placement has to be expressed with one
+		// resource per zone rather than a list of zones.
+		if strings.ContainsAny(value, ",; []\"") {
+			return diag.Errorf(
+				"invalid zone %q: a single zone is expected, placement across multiple zones "+
+					"requires one resource per zone (e.g. using for_each)",
+				value,
+			)
+		}
+
+		if suggestion := closestString(config.Zones, value); suggestion != "" {
+			return diag.Errorf("invalid zone %q, did you mean %q?", value, suggestion)
+		}
+
+		return diag.Errorf(
+			"invalid zone %q, expected one of: %s",
+			value,
+			strings.Join(config.Zones, ", "),
+		)
+	}
+}
+
+// closestString returns the item of list closest to s (in terms of Levenshtein
+// distance), if close enough to be considered a typo, otherwise an empty string.
+func closestString(list []string, s string) string {
+	const maxDistance = 3
+
+	var (
+		closest  string
+		distance = maxDistance + 1
+	)
+	for _, item := range list {
+		if d := levenshtein(strings.ToLower(s), item); d < distance {
+			closest, distance = item, d
+		}
+	}
+
+	return closest
+}
+
+// levenshtein returns the Levenshtein distance between strings a and b.
+func levenshtein(a, b string) int {
+	prev := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		cur := make([]int, len(b)+1)
+		cur[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			cur[j] = prev[j-1] + cost
+			if v := prev[j] + 1; v < cur[j] {
+				cur[j] = v
+			}
+			if v := cur[j-1] + 1; v < cur[j] {
+				cur[j] = v
+			}
+		}
+		prev = cur
+	}
+
+	return prev[len(b)]
 }
 
 // ValidateComputeInstanceType validates that the given field contains a valid Exoscale Compute instance type.
@@ -232,6 +341,50 @@ func ValidateComputeInstanceType(v interface{}, _ cty.Path) diag.Diagnostics {
 	return nil
 }
 
+// InstancePrefixMaxLength is the maximum length of the prefix of the names of the
+// instances managed by an Instance Pool: names are generated as "<prefix>-xxxxx-xxxxx"
+// and must fit in a DNS label.
+const InstancePrefixMaxLength = dnsLabelMaxLength - len("-xxxxx-xxxxx")
+
+// dnsLabelMaxLength is the maximum length of a DNS label (RFC 1035).
+const dnsLabelMaxLength = 63
+
+// instancePrefixRegexp matches the characters allowed in a DNS label, starting
+// with a letter or a digit.
+var instancePrefixRegexp = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9-]*$`)
+
+// ValidateInstancePrefix validates that the given field contains a prefix producing
+// valid hostnames for the instances managed by an Instance Pool (see InstancePrefixMaxLength).
+func ValidateInstancePrefix(v interface{}, _ cty.Path) diag.Diagnostics {
+	value, ok := v.(string)
+	if !ok {
+		return diag.Errorf("expected instance prefix type to be string, got %T", v)
+	}
+
+	// An empty prefix lets the API use its default.
+	if value == "" {
+		return nil
+	}
+
+	if len(value) > InstancePrefixMaxLength {
+		return diag.Errorf(
+			"invalid instance prefix %q: must be at most %d characters long (generated instance names must fit in a %d characters DNS label)",
+			value,
+			InstancePrefixMaxLength,
+			dnsLabelMaxLength,
+		)
+	}
+
+	if !instancePrefixRegexp.MatchString(value) {
+		return diag.Errorf(
+			"invalid instance prefix %q: must only contain letters, digits and hyphens, and start with a letter or a digit",
+			value,
+		)
+	}
+
+	return nil
+}
+
 // ValidateComputeUserData validates that the given field contains a valid data.
 func ValidateComputeUserData(v interface{}, _ cty.Path) diag.Diagnostics {
 	value, ok := v.(string)
@@ -255,3 +408,202 @@ func ValidateLowercaseString(val interface{}, key string) (warns []string, errs
 	}
 	return
 }
+
+// QuotaGetter is the subset of the Exoscale API client required by CheckQuota.
+type QuotaGetter interface {
+	GetQuota(ctx context.Context, zone, resource string) (*egoscale.Quota, error)
+}
+
+// CheckQuota ensures that requested additional units of the specified resource
+// (e.g. "instance") fit in the organization quota, returning an error naming
+// the exhausted quota otherwise. Failing to retrieve the quota is not an error:
+// the check is skipped and the API remains the final authority.
+func CheckQuota(ctx context.Context, client QuotaGetter, zone, resource string, requested int64) error {
+	quota, err := client.GetQuota(ctx, zone, resource)
+	if err != nil || quota == nil {
+		tflog.Warn(ctx, "unable to retrieve quota, skipping quota check", map[string]interface{}{
+			"resource": resource,
+			"error":    fmt.Sprint(err),
+		})
+		return nil
+	}
+
+	limit := DefaultInt64(quota.Limit, -1)
+	usage := DefaultInt64(quota.Usage, 0)
+	if limit < 0 || usage+requested <= limit {
+		return nil
+	}
+
+	return fmt.Errorf(
+		"%s quota exhausted: %d/%d used, %d more requested (a quota increase can be requested from the Exoscale Portal)",
+		resource,
+		usage,
+		limit,
+		requested,
+	)
+}
+
+// CheckQuotaDiff ensures, when quota checks are enabled (see config.QuotaChecksEnabled),
+// that the requested additional units of the resource (e.g. "instance") planned by a
+// CustomizeDiff function fit in the organization quota (see CheckQuota), so that an
+// exhausted quota is reported at plan time rather than by the API at apply time.
+// The check is skipped until the zone is known. Each resource is checked on its own:
+// the units requested by other resources of the same plan are not accounted for.
+func CheckQuotaDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}, resource string, requested int64) error {
+	if requested <= 0 || meta == nil || !config.QuotaChecksEnabled(meta) || !d.NewValueKnown("zone") {
+		return nil
+	}
+
+	client, err := config.GetClient(meta)
+	if err != nil {
+		return err
+	}
+
+	zone := d.Get("zone").(string)
+	ctx = exoapi.WithEndpoint(ctx, exoapi.NewReqEndpoint(config.GetEnvironment(meta), zone))
+
+	return CheckQuota(ctx, client, zone, resource, requested)
+}
+
+// QuotaCustomizeDiff returns a CustomizeDiff function checking that the creation of
+// a resource consuming one unit of the specified quota fits in it (see CheckQuotaDiff).
+func QuotaCustomizeDiff(resource string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		if d.Id() != "" {
+			return nil
+		}
+
+		return CheckQuotaDiff(ctx, d, meta, resource, 1)
+	}
+}
+
+// AttrWaitForDeletion is the name of the opt-in resource attribute enabling WaitForDeletion.
+const AttrWaitForDeletion = "wait_for_deletion"
+
+// waitForDeletionInterval is the interval at which WaitForDeletion checks the resource existence.
+var waitForDeletionInterval = oapi.DefaultPollingInterval
+
+// WaitForDeletionSchema returns the schema of the opt-in wait_for_deletion resource attribute.
+func WaitForDeletionSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  false,
+		Description: "Wait for the resource to be completely gone when deleting it, instead of returning as soon as " +
+			"the deletion has been accepted, so that dependent resources can be deleted safely (default: `false`).",
+	}
+}
+
+// WaitForDeletion polls exists until the resource is reported as gone, or the context
+// is done (e.g. when the delete timeout is reached).
+func WaitForDeletion(ctx context.Context, exists func(context.Context) (bool, error)) error {
+	_, err := oapi.NewPoller().
+		WithInterval(waitForDeletionInterval).
+		Poll(ctx, func(ctx context.Context) (bool, interface{}, error) {
+			found, err := exists(ctx)
+			if err != nil {
+				return false, nil, err
+			}
+
+			return !found, nil, nil
+		})
+	if err != nil {
+		return fmt.Errorf("error waiting for deletion: %w", err)
+	}
+
+	return nil
+}
+
+// ErrTooManyFound is the error matched by lookups returning several resources
+// where a single one is expected (e.g. a name shared by several resources).
+var ErrTooManyFound = exoapi.ErrTooManyFound
+
+// tooManyFoundError reports several resources of the same kind sharing a name.
+type tooManyFoundError struct {
+	kind string
+	name string
+}
+
+func (e *tooManyFoundError) Error() string {
+	return fmt.Sprintf("multiple %s named %q, specify id", e.kind, e.name)
+}
+
+func (e *tooManyFoundError) Is(target error) bool {
+	return target == ErrTooManyFound
+}
+
+// TooManyFoundError returns an error matching ErrTooManyFound, reporting that
+// several resources of the specified kind (e.g. "networks") are named name.
+// It is meant to be used by name-based lookups, so that they all fail with
+// the same message.
+func TooManyFoundError(kind, name string) error {
+	return &tooManyFoundError{kind: kind, name: name}
+}
+
+// ReplacementDiff is the subset of *schema.ResourceDiff used by ReplacementReasons.
+type ReplacementDiff interface {
+	Id() string
+	HasChange(key string) bool
+}
+
+// ReplacementReasons returns the explanations of the changes of the planned
+// attributes triggering the replacement of an existing resource, sorted by
+// attribute. impacts maps ForceNew attributes to the impact of their replacement
+// (e.g. "the instances lose their addresses").
+func ReplacementReasons(d ReplacementDiff, impacts map[string]string) []string {
+	if d.Id() == "" {
+		return nil
+	}
+
+	reasons := make([]string, 0)
+	for key, impact := range impacts {
+		if d.HasChange(key) {
+			reasons = append(reasons, fmt.Sprintf("changing %s forces the replacement of the resource: %s", key, impact))
+		}
+	}
+	sort.Strings(reasons)
+
+	return reasons
+}
+
+// replacementWarnings collects the explanations of the replacements planned
+// during a plan operation.
+type replacementWarnings struct {
+	mu      sync.Mutex
+	reasons []string
+}
+
+type replacementWarningsKey struct{}
+
+// WithReplacementWarnings returns a context collecting the explanations of the
+// replacements planned with it by WarnReplacement, and a function returning them.
+func WithReplacementWarnings(ctx context.Context) (context.Context, func() []string) {
+	warnings := &replacementWarnings{}
+
+	return context.WithValue(ctx, replacementWarningsKey{}, warnings), func() []string {
+		warnings.mu.Lock()
+		defer warnings.mu.Unlock()
+
+		return append([]string(nil), warnings.reasons...)
+	}
+}
+
+// WarnReplacement explains why an existing resource is planned for replacement
+// (see ReplacementReasons): CustomizeDiff functions cannot return warning
+// diagnostics, the explanations are recorded in the context (see
+// WithReplacementWarnings) to be reported by the provider server, and logged.
+func WarnReplacement(ctx context.Context, d ReplacementDiff, impacts map[string]string) {
+	warnings, _ := ctx.Value(replacementWarningsKey{}).(*replacementWarnings)
+
+	for _, reason := range ReplacementReasons(d, impacts) {
+		if warnings != nil {
+			warnings.mu.Lock()
+			warnings.reasons = append(warnings.reasons, reason)
+			warnings.mu.Unlock()
+		}
+
+		tflog.Warn(ctx, reason, map[string]interface{}{
+			"id": d.Id(),
+		})
+	}
+}
@@ -0,0 +1,346 @@
+package utils
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-cty/cty"
+
+	egoscale "github.com/exoscale/egoscale/v2"
+)
+
+func Test_ValidateZone(t *testing.T) {
+	tests := []struct {
+		name    string
+		v       interface{}
+		wantErr *regexp.Regexp
+	}{
+		{
+			name:    "invalid type",
+			v:       42,
+			wantErr: regexp.MustCompile("type to be string, got int$"),
+		},
+		{
+			name: "known zone",
+			v:    "ch-gva-2",
+		},
+		{
+			name:    "typo",
+			v:       "ch-gav-2",
+			wantErr: regexp.MustCompile(`did you mean "ch-gva-2"\?`),
+		},
+		{
+			name:    "wrong case",
+			v:       "DE-FRA-1",
+			wantErr: regexp.MustCompile(`did you mean "de-fra-1"\?`),
+		},
+		{
+			name:    "multiple zones",
+			v:       "ch-gva-2,de-fra-1",
+			wantErr: regexp.MustCompile("a single zone is expected"),
+		},
+		{
+			name:    "list of zones",
+			v:       `["ch-gva-2", "de-fra-1"]`,
+			wantErr: regexp.MustCompile("one resource per zone"),
+		},
+		{
+			name:    "unknown zone",
+			v:       "us-east-1",
+			wantErr: regexp.MustCompile("expected one of: ch-gva-2"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diags := ValidateZone()(tt.v, cty.GetAttrPath("zone"))
+			if tt.wantErr == nil {
+				if diags.HasError() {
+					t.Fatalf("unexpected error: %v", diags)
+				}
+				return
+			}
+
+			if !diags.HasError() {
+				t.Fatal("expected an error, got none")
+			}
+			if !tt.wantErr.MatchString(diags[0].Summary) {
+				t.Fatalf("expected error matching %q, got %q", tt.wantErr, diags[0].Summary)
+			}
+		})
+	}
+}
+
+type testQuotaGetter func(ctx context.Context, zone, resource string) (*egoscale.Quota, error)
+
+func (f testQuotaGetter) GetQuota(ctx context.Context, zone, resource string) (*egoscale.Quota, error) {
+	return f(ctx, zone, resource)
+}
+
+func Test_CheckQuota(t *testing.T) {
+	quota := func(limit, usage int64) testQuotaGetter {
+		return func(_ context.Context, _, resource string) (*egoscale.Quota, error) {
+			return &egoscale.Quota{Resource: &resource, Limit: &limit, Usage: &usage}, nil
+		}
+	}
+
+	tests := []struct {
+		name      string
+		client    QuotaGetter
+		requested int64
+		wantErr   *regexp.Regexp
+	}{
+		{
+			name:      "within quota",
+			client:    quota(10, 5),
+			requested: 5,
+		},
+		{
+			name:      "unlimited",
+			client:    quota(-1, 1000),
+			requested: 1,
+		},
+		{
+			name:      "quota exhausted",
+			client:    quota(10, 10),
+			requested: 1,
+			wantErr:   regexp.MustCompile(`^instance quota exhausted: 10/10 used, 1 more requested`),
+		},
+		{
+			name:      "quota exceeded by request",
+			client:    quota(10, 8),
+			requested: 3,
+			wantErr:   regexp.MustCompile(`^instance quota exhausted: 8/10 used, 3 more requested`),
+		},
+		{
+			name: "quota unavailable",
+			client: testQuotaGetter(func(_ context.Context, _, _ string) (*egoscale.Quota, error) {
+				return nil, errors.New("forbidden")
+			}),
+			requested: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := CheckQuota(context.Background(), tt.client, "ch-gva-2", "instance", tt.requested)
+			if tt.wantErr == nil {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+
+			if err == nil {
+				t.Fatal("expected an error, got none")
+			}
+			if !tt.wantErr.MatchString(err.Error()) {
+				t.Fatalf("expected error matching %q, got %q", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func Test_WaitForDeletion(t *testing.T) {
+	defer func(interval time.Duration) { waitForDeletionInterval = interval }(waitForDeletionInterval)
+	waitForDeletionInterval = time.Millisecond
+
+	t.Run("deleted", func(t *testing.T) {
+		checks := 0
+		err := WaitForDeletion(context.Background(), func(_ context.Context) (bool, error) {
+			checks++
+			return checks < 3, nil
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if checks != 3 {
+			t.Fatalf("expected 3 checks, got %d", checks)
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		err := WaitForDeletion(context.Background(), func(_ context.Context) (bool, error) {
+			return false, errors.New("forbidden")
+		})
+		if err == nil || err.Error() != "error waiting for deletion: forbidden" {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		err := WaitForDeletion(ctx, func(_ context.Context) (bool, error) {
+			return true, nil
+		})
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Fatalf("expected a deadline exceeded error, got %v", err)
+		}
+	})
+}
+
+func Test_SuppressUserDataDiff(t *testing.T) {
+	const userData = "#cloud-config\npackage_upgrade: true\n"
+
+	var gzipped bytes.Buffer
+	gz := gzip.NewWriter(&gzipped)
+	if _, err := gz.Write([]byte(userData)); err != nil {
+		t.Fatal(err)
+	}
+	if err := gz.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		old  string
+		new  string
+		want bool
+	}{
+		{
+			name: "plain",
+			old:  userData,
+			new:  userData,
+			want: true,
+		},
+		{
+			name: "base64",
+			old:  userData,
+			new:  base64.StdEncoding.EncodeToString([]byte(userData)),
+			want: true,
+		},
+		{
+			name: "gzipped base64",
+			old:  userData,
+			new:  base64.StdEncoding.EncodeToString(gzipped.Bytes()),
+			want: true,
+		},
+		{
+			name: "gzipped",
+			old:  base64.StdEncoding.EncodeToString([]byte(userData)),
+			new:  gzipped.String(),
+			want: true,
+		},
+		{
+			name: "different",
+			old:  userData,
+			new:  base64.StdEncoding.EncodeToString([]byte(userData + "package_update: true\n")),
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SuppressUserDataDiff("user_data", tt.old, tt.new, nil); got != tt.want {
+				t.Fatalf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func Test_TooManyFoundError(t *testing.T) {
+	err := fmt.Errorf("lookup failed: %w", TooManyFoundError("networks", "private"))
+
+	if !errors.Is(err, ErrTooManyFound) {
+		t.Fatalf("expected error %q to match ErrTooManyFound", err)
+	}
+
+	if want := `lookup failed: multiple networks named "private", specify id`; err.Error() != want {
+		t.Fatalf("expected error %q, got %q", want, err)
+	}
+}
+
+type testReplacementDiff struct {
+	id      string
+	changed []string
+}
+
+func (d testReplacementDiff) Id() string { return d.id }
+
+func (d testReplacementDiff) HasChange(key string) bool { return In(d.changed, key) }
+
+func Test_ReplacementReasons(t *testing.T) {
+	impacts := map[string]string{
+		"zone": "the resource is deleted and created in the new zone",
+		"cni":  "the cluster is deleted",
+	}
+
+	if reasons := ReplacementReasons(testReplacementDiff{changed: []string{"zone"}}, impacts); len(reasons) != 0 {
+		t.Fatalf("expected no reasons for a new resource, got %q", reasons)
+	}
+
+	if reasons := ReplacementReasons(testReplacementDiff{id: "x", changed: []string{"name"}}, impacts); len(reasons) != 0 {
+		t.Fatalf("expected no reasons for in-place changes, got %q", reasons)
+	}
+
+	reasons := ReplacementReasons(testReplacementDiff{id: "x", changed: []string{"zone", "cni", "name"}}, impacts)
+	want := []string{
+		"changing cni forces the replacement of the resource: the cluster is deleted",
+		"changing zone forces the replacement of the resource: the resource is deleted and created in the new zone",
+	}
+	if fmt.Sprint(reasons) != fmt.Sprint(want) {
+		t.Fatalf("expected reasons %q, got %q", want, reasons)
+	}
+}
+
+func Test_ValidateInstancePrefix(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   interface{}
+		wantErr *regexp.Regexp
+	}{
+		{name: "default", value: "pool"},
+		{name: "empty", value: ""},
+		{name: "hyphens and digits", value: "k8s-prod-1"},
+		{name: "max length", value: strings.Repeat("a", InstancePrefixMaxLength)},
+		{
+			name:    "too long",
+			value:   strings.Repeat("a", InstancePrefixMaxLength+1),
+			wantErr: regexp.MustCompile(fmt.Sprintf("must be at most %d characters long", InstancePrefixMaxLength)),
+		},
+		{
+			name:    "illegal characters",
+			value:   "my_pool.prod",
+			wantErr: regexp.MustCompile("must only contain letters, digits and hyphens"),
+		},
+		{
+			name:    "leading hyphen",
+			value:   "-pool",
+			wantErr: regexp.MustCompile("start with a letter or a digit"),
+		},
+		{
+			name:    "not a string",
+			value:   42,
+			wantErr: regexp.MustCompile("type to be string, got int$"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diags := ValidateInstancePrefix(tt.value, cty.Path{})
+			if tt.wantErr == nil {
+				if diags.HasError() {
+					t.Fatalf("expected no error, got %v", diags)
+				}
+				return
+			}
+
+			if !diags.HasError() {
+				t.Fatalf("expected an error matching %q", tt.wantErr)
+			}
+			if !tt.wantErr.MatchString(diags[0].Summary) {
+				t.Fatalf("expected an error matching %q, got %q", tt.wantErr, diags[0].Summary)
+			}
+		})
+	}
+}